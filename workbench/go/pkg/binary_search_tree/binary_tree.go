@@ -4,8 +4,10 @@ package binary_search_tree
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"hash"
 	"hash/fnv"
+	"io"
 	"math"
 	"sync"
 )
@@ -29,7 +31,9 @@ var hasherPool = sync.Pool{
 type BinaryTree[V comparable] struct {
 	root *Node[uint64, V]
 	size int
-	mu   sync.RWMutex
+	// balanced enables AVL rebalancing on insert and delete
+	balanced bool
+	mu       sync.RWMutex
 }
 
 // NewBinaryTree creates and returns a new empty BinaryTree.
@@ -41,6 +45,18 @@ func NewBinaryTree[V comparable]() (*BinaryTree[V], error) {
 	}, nil
 }
 
+// NewAVLTree creates and returns a new empty self-balancing BinaryTree.
+// After every insertion and deletion the tree performs AVL rotations so that the heights
+// of the two subtrees of any node differ by at most 1, guaranteeing O(log n) operations
+// even for adversarial or clustered inputs.
+func NewAVLTree[V comparable]() (*BinaryTree[V], error) {
+	return &BinaryTree[V]{
+		root:     nil,
+		size:     0,
+		balanced: true,
+	}, nil
+}
+
 // Size returns the total number of nodes currently in the tree.
 // This method is thread-safe.
 func (tree *BinaryTree[V]) Size() int {
@@ -52,6 +68,7 @@ func (tree *BinaryTree[V]) Size() int {
 // InsertInOrder inserts a new value into the binary search tree.
 // It calculates a hash of the value to use as the key, then inserts the
 // new node while maintaining the binary search tree property.
+// For trees created with NewAVLTree, the tree is rebalanced after insertion.
 // The tree's size is incremented on successful insertion.
 // This method is thread-safe.
 func (tree *BinaryTree[V]) InsertInOrder(value V) error {
@@ -70,6 +87,13 @@ func (tree *BinaryTree[V]) InsertInOrder(value V) error {
 		return nil
 	}
 
+	if tree.balanced {
+		tree.root = tree.root.insertBalanced(key, value)
+		tree.root.parent = nil
+		tree.size++
+		return nil
+	}
+
 	err = tree.root.insertInOrder(key, value)
 	if err == nil {
 		tree.size++
@@ -81,6 +105,7 @@ func (tree *BinaryTree[V]) InsertInOrder(value V) error {
 // It returns the hash key of the deleted value and an error if the operation fails.
 // If the tree is empty, it returns ErrorNodeIsNil.
 // If the node is not found, it returns the key but doesn't modify the tree.
+// For trees created with NewAVLTree, the tree is rebalanced after deletion.
 // The tree's size is decremented only on successful deletion.
 // This method is thread-safe.
 func (tree *BinaryTree[V]) Delete(value V) (uint64, error) {
@@ -96,11 +121,14 @@ func (tree *BinaryTree[V]) Delete(value V) (uint64, error) {
 		return 0, err
 	}
 
-	_root, err := tree.root.delete(key, value)
+	_root, err := tree.root.delete(key, value, tree.balanced)
 	if err != nil {
 		return 0, err // Other errors
 	}
 	tree.root = _root
+	if tree.root != nil {
+		tree.root.parent = nil
+	}
 	// Decrement size only on successful deletion
 	tree.size--
 	return key, nil
@@ -124,6 +152,350 @@ func (tree *BinaryTree[V]) Find(value V) (*Node[uint64, V], error) {
 	return tree.root.find(key, value), nil
 }
 
+// FindByKey searches for a node whose hash key equals key, skipping the hashing step.
+// The key is the value returned by Delete or stored in a node found by Find.
+// It returns a pointer to the found Node or nil if no node has that key. If several
+// values share the key, any one of them may be returned.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) FindByKey(key uint64) (*Node[uint64, V], error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	if tree.root == nil {
+		return nil, ErrorNodeIsNil
+	}
+	return tree.root.findKey(key), nil
+}
+
+// RotateLeft rotates the subtree rooted at node to the left and returns the new subtree root,
+// the former right child of node. The left, right and parent pointers and the cached subtree
+// heights are rewired, and the new subtree root takes node's place under its parent
+// (or becomes the tree root). In-order traversal is unchanged, so it can be used to build
+// custom balancing on top of Find and FindByKey.
+// node must belong to this tree. It returns ErrorNodeIsNil if node is nil, or
+// ErrorCannotRotate if node has no right child.
+// This method is thread-safe.
+func (tree *BinaryTree[V]) RotateLeft(node *Node[uint64, V]) (*Node[uint64, V], error) {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	root, pivot, err := rotateInTree(tree.root, node, true)
+	if err != nil {
+		return nil, err
+	}
+	tree.root = root
+	return pivot, nil
+}
+
+// RotateRight rotates the subtree rooted at node to the right and returns the new subtree root,
+// the former left child of node. The left, right and parent pointers and the cached subtree
+// heights are rewired, and the new subtree root takes node's place under its parent
+// (or becomes the tree root). In-order traversal is unchanged, so it can be used to build
+// custom balancing on top of Find and FindByKey.
+// node must belong to this tree. It returns ErrorNodeIsNil if node is nil, or
+// ErrorCannotRotate if node has no left child.
+// If the left child has a key equal to node's, node ends up in its right subtree; Find still
+// locates such nodes, but Validate reports ErrorInvalidTree unless the tree
+// was created with NewAVLTree.
+// This method is thread-safe.
+func (tree *BinaryTree[V]) RotateRight(node *Node[uint64, V]) (*Node[uint64, V], error) {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	root, pivot, err := rotateInTree(tree.root, node, false)
+	if err != nil {
+		return nil, err
+	}
+	tree.root = root
+	return pivot, nil
+}
+
+// DeleteByKey removes a node whose hash key equals key, skipping the hashing step.
+// If several values share the key, only one of them is removed.
+// If the tree is empty, it returns ErrorNodeIsNil; if no node has that key, it returns
+// ErrorNodeNotFound and leaves the tree unchanged.
+// For trees created with NewAVLTree, the tree is rebalanced after deletion.
+// This method is thread-safe.
+func (tree *BinaryTree[V]) DeleteByKey(key uint64) error {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	if tree.root == nil {
+		return ErrorNodeIsNil
+	}
+	target := tree.root.findKey(key)
+	if target == nil {
+		return ErrorNodeNotFound
+	}
+
+	_root, err := tree.root.delete(key, target.value, tree.balanced)
+	if err != nil {
+		return err
+	}
+	tree.root = _root
+	if tree.root != nil {
+		tree.root.parent = nil
+	}
+	tree.size--
+	return nil
+}
+
+// InOrder returns all values in the tree using a left-root-right traversal.
+// Since keys are hashes of the values, the values are returned in ascending hash-key order.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) InOrder() []V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.inOrder(make([]V, 0, tree.size))
+}
+
+// PreOrder returns all values in the tree using a root-left-right traversal.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) PreOrder() []V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.preOrder(make([]V, 0, tree.size))
+}
+
+// PostOrder returns all values in the tree using a left-right-root traversal.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) PostOrder() []V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.postOrder(make([]V, 0, tree.size))
+}
+
+// LevelOrder returns all values in the tree grouped by depth using a breadth-first traversal.
+// The root is level 0, and values within a level are ordered from left to right.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) LevelOrder() [][]V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.levelOrder(tree.size)
+}
+
+// Height returns the number of edges on the longest root-to-leaf path.
+// A tree with a single node has height 0 and an empty tree has height -1.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Height() int {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.height()
+}
+
+// IsBalanced reports whether the heights of the left and right subtrees of every node
+// differ by at most 1. An empty tree is balanced.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) IsBalanced() bool {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.isBalanced()
+}
+
+// Min returns the value with the smallest key in the tree.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Min() (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	node, err := tree.root.findMin()
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	return node.value, nil
+}
+
+// Max returns the value with the largest key in the tree.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Max() (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	node, err := tree.root.findMax()
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	return node.value, nil
+}
+
+// Successor returns the value that follows the given value in key order.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// If the value is not present, it returns ErrorNodeNotFound.
+// If the value is the last one in key order, it returns ErrorNoSuccessor.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Successor(value V) (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	var zero V
+	node, err := tree.lookup(value)
+	if err != nil {
+		return zero, err
+	}
+	next := node.successor()
+	if next == nil {
+		return zero, ErrorNoSuccessor
+	}
+	return next.value, nil
+}
+
+// Predecessor returns the value that precedes the given value in key order.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// If the value is not present, it returns ErrorNodeNotFound.
+// If the value is the first one in key order, it returns ErrorNoPredecessor.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Predecessor(value V) (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	var zero V
+	node, err := tree.lookup(value)
+	if err != nil {
+		return zero, err
+	}
+	prev := node.predecessor()
+	if prev == nil {
+		return zero, ErrorNoPredecessor
+	}
+	return prev.value, nil
+}
+
+// Validate checks that the tree satisfies the binary search tree invariants:
+// for every node, keys in the left subtree are <= its key and keys in the right subtree
+// are > its key (matching insertInOrder's tie-breaking), every parent pointer is consistent,
+// and the number of reachable nodes equals Size().
+// For trees created with NewAVLTree, equal keys are also accepted in the right subtree
+// because rotations may move duplicates there.
+// It returns nil for a valid tree, or an error wrapping ErrorInvalidTree that describes
+// the first violating node.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Validate() error {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	count, err := tree.root.validate(nil, nil, nil, tree.balanced)
+	if err != nil {
+		return err
+	}
+	if count != tree.size {
+		return fmt.Errorf("%w: found %d nodes but size is %d", ErrorInvalidTree, count, tree.size)
+	}
+	return nil
+}
+
+// Analyze returns the tree's size, height, minimum and maximum keys, number of nodes
+// with two children, and validity in a single O(n) traversal.
+// Since keys are hashes of the values, MinKey and MaxKey are hash keys.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Analyze() TreeStats[uint64] {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return analyzeTree(tree.root, tree.size, tree.balanced)
+}
+
+// ToDOT writes the tree to w as a Graphviz digraph, labeling each node with its value.
+// Render it with, for example, `dot -Tpng tree.dot -o tree.png`.
+// It returns the first error encountered while writing to w.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) ToDOT(w io.Writer) error {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return writeDOT(w, tree.root)
+}
+
+// Clone returns an independent deep copy of the tree.
+// All nodes are newly allocated with rebuilt parent pointers, so mutating the clone
+// does not affect the original tree and vice versa.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Clone() (*BinaryTree[V], error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	return &BinaryTree[V]{
+		root:     tree.root.clone(nil),
+		size:     tree.size,
+		balanced: tree.balanced,
+	}, nil
+}
+
+// Clear removes all nodes from the tree by dropping the root and resetting the size to 0.
+// This method is thread-safe.
+func (tree *BinaryTree[V]) Clear() {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	tree.root = nil
+	tree.size = 0
+}
+
+// Contains reports whether the tree holds the given value.
+// An empty tree returns false without an error.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Contains(value V) (bool, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	key, err := tree.getHash(value)
+	if err != nil {
+		return false, err
+	}
+	return tree.root.find(key, value) != nil, nil
+}
+
+// Count returns how many nodes hold the given value.
+// Since duplicates are inserted into the left subtree, it walks every node with an equal key.
+// An empty tree returns 0 without an error.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Count(value V) (int, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	key, err := tree.getHash(value)
+	if err != nil {
+		return 0, err
+	}
+	return tree.root.count(key, value), nil
+}
+
+// Iterator returns a cursor that lazily yields the tree's values in in-order (ascending key) order.
+// Only the nodes on the current path are kept, so memory usage is O(h) and iteration can stop early.
+// The tree must not be modified while the iterator is in use.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *BinaryTree[V]) Iterator() *Iterator[uint64, V] {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return newIterator(tree.root, tree.root.cachedHeight(), &tree.mu)
+}
+
+// lookup finds the node holding value without locking.
+// It returns ErrorNodeIsNil for an empty tree and ErrorNodeNotFound if the value is not present.
+// This method assumes the caller already holds the appropriate lock.
+func (tree *BinaryTree[V]) lookup(value V) (*Node[uint64, V], error) {
+	if tree.root == nil {
+		return nil, ErrorNodeIsNil
+	}
+	key, err := tree.getHash(value)
+	if err != nil {
+		return nil, err
+	}
+	node := tree.root.find(key, value)
+	if node == nil {
+		return nil, ErrorNodeNotFound
+	}
+	return node, nil
+}
+
 // getHash computes and returns the FNV-1a hash of a given value.
 // It uses a sync.Pool to reuse hasher objects, making it safe for concurrent use
 // and avoiding allocations on each call.
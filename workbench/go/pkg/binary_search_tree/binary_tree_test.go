@@ -311,3 +311,408 @@ func TestBinaryTree_Delete(t *testing.T) {
 		assert.Nil(t, tree.root)
 	})
 }
+
+func TestBinaryTree_Traversal(t *testing.T) {
+	t.Run("empty tree", func(t *testing.T) {
+		tree, err := NewBinaryTree[string]()
+		require.NoError(t, err)
+		assert.Empty(t, tree.InOrder())
+		assert.Empty(t, tree.PreOrder())
+		assert.Empty(t, tree.PostOrder())
+	})
+
+	t.Run("in-order follows hash keys", func(t *testing.T) {
+		tree, err := NewBinaryTree[string]()
+		require.NoError(t, err)
+		values := []string{"apple", "orange", "banana", "grape", "pineapple"}
+		for _, v := range values {
+			require.NoError(t, tree.InsertInOrder(v))
+		}
+
+		inOrder := tree.InOrder()
+		assert.ElementsMatch(t, values, inOrder)
+		for i := 1; i < len(inOrder); i++ {
+			prev, err := tree.getHash(inOrder[i-1])
+			require.NoError(t, err)
+			curr, err := tree.getHash(inOrder[i])
+			require.NoError(t, err)
+			assert.LessOrEqual(t, prev, curr)
+		}
+
+		preOrder := tree.PreOrder()
+		assert.ElementsMatch(t, values, preOrder)
+		assert.Equal(t, values[0], preOrder[0], "pre-order starts at the root")
+
+		postOrder := tree.PostOrder()
+		assert.ElementsMatch(t, values, postOrder)
+		assert.Equal(t, values[0], postOrder[len(postOrder)-1], "post-order ends at the root")
+	})
+}
+
+func TestBinaryTree_HeightAndBalance(t *testing.T) {
+	tree, err := NewBinaryTree[int]()
+	require.NoError(t, err)
+	assert.Equal(t, -1, tree.Height())
+	assert.True(t, tree.IsBalanced())
+
+	require.NoError(t, tree.InsertInOrder(1))
+	assert.Equal(t, 0, tree.Height())
+	assert.True(t, tree.IsBalanced())
+
+	for i := 2; i <= 100; i++ {
+		require.NoError(t, tree.InsertInOrder(i))
+	}
+	assert.Equal(t, tree.root.height(), tree.Height())
+	assert.GreaterOrEqual(t, tree.Height(), 6) // at least ceil(log2(101)) - 1
+	assert.Less(t, tree.Height(), 100)
+	assert.Equal(t, tree.root.isBalanced(), tree.IsBalanced())
+}
+
+func TestBinaryTree_MinMaxSuccessorPredecessor(t *testing.T) {
+	tree, err := NewBinaryTree[string]()
+	require.NoError(t, err)
+
+	_, err = tree.Min()
+	assert.ErrorIs(t, err, ErrorNodeIsNil)
+	_, err = tree.Successor("apple")
+	assert.ErrorIs(t, err, ErrorNodeIsNil)
+
+	for _, v := range []string{"apple", "orange", "banana", "grape", "pineapple"} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+	inOrder := tree.InOrder()
+
+	minValue, err := tree.Min()
+	require.NoError(t, err)
+	assert.Equal(t, inOrder[0], minValue)
+	maxValue, err := tree.Max()
+	require.NoError(t, err)
+	assert.Equal(t, inOrder[len(inOrder)-1], maxValue)
+
+	for i := 0; i < len(inOrder)-1; i++ {
+		next, err := tree.Successor(inOrder[i])
+		require.NoError(t, err)
+		assert.Equal(t, inOrder[i+1], next)
+
+		prev, err := tree.Predecessor(inOrder[i+1])
+		require.NoError(t, err)
+		assert.Equal(t, inOrder[i], prev)
+	}
+
+	_, err = tree.Successor(maxValue)
+	assert.ErrorIs(t, err, ErrorNoSuccessor)
+	_, err = tree.Predecessor(minValue)
+	assert.ErrorIs(t, err, ErrorNoPredecessor)
+	_, err = tree.Successor("watermelon")
+	assert.ErrorIs(t, err, ErrorNodeNotFound)
+}
+
+func TestBinaryTree_LevelOrder(t *testing.T) {
+	tree, err := NewBinaryTree[string]()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{}, tree.LevelOrder())
+
+	values := []string{"apple", "orange", "banana", "grape", "pineapple"}
+	for _, v := range values {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	levels := tree.LevelOrder()
+	require.Len(t, levels, tree.Height()+1)
+	assert.Equal(t, []string{"apple"}, levels[0])
+
+	var flattened []string
+	for _, level := range levels {
+		flattened = append(flattened, level...)
+	}
+	assert.ElementsMatch(t, values, flattened)
+}
+
+func TestAVLTree(t *testing.T) {
+	tree, err := NewAVLTree[int]()
+	require.NoError(t, err)
+
+	const n = 2000
+	for i := 0; i < n; i++ {
+		require.NoError(t, tree.InsertInOrder(i))
+		require.Nil(t, tree.root.parent)
+	}
+	assert.Equal(t, n, tree.Size())
+	assert.True(t, tree.IsBalanced())
+	assert.LessOrEqual(t, tree.Height(), 16) // < 1.44 * log2(n + 2)
+
+	for i := 0; i < n; i++ {
+		node, err := tree.Find(i)
+		require.NoError(t, err)
+		require.NotNil(t, node)
+	}
+
+	for i := 0; i < n; i += 2 {
+		_, err = tree.Delete(i)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, n/2, tree.Size())
+	assert.True(t, tree.IsBalanced())
+	assert.ElementsMatch(t, func() []int {
+		odd := make([]int, 0, n/2)
+		for i := 1; i < n; i += 2 {
+			odd = append(odd, i)
+		}
+		return odd
+	}(), tree.InOrder())
+}
+
+func TestBinaryTree_Validate(t *testing.T) {
+	tree, err := NewBinaryTree[int]()
+	require.NoError(t, err)
+	assert.NoError(t, tree.Validate())
+
+	for i := 0; i < 200; i++ {
+		require.NoError(t, tree.InsertInOrder(i))
+	}
+	require.NoError(t, tree.Validate())
+
+	// Deleting in an interleaved order exercises every deletion case including the root
+	for i := 0; i < 200; i += 3 {
+		_, err = tree.Delete(i)
+		require.NoError(t, err)
+		require.NoError(t, tree.Validate(), "invalid after deleting %d", i)
+	}
+
+	t.Run("size mismatch", func(t *testing.T) {
+		tree.size++
+		assert.ErrorIs(t, tree.Validate(), ErrorInvalidTree)
+		tree.size--
+	})
+
+	t.Run("avl tree", func(t *testing.T) {
+		avl, err := NewAVLTree[int]()
+		require.NoError(t, err)
+		for i := 0; i < 200; i++ {
+			require.NoError(t, avl.InsertInOrder(i))
+		}
+		for i := 0; i < 200; i += 3 {
+			_, err = avl.Delete(i)
+			require.NoError(t, err)
+		}
+		assert.NoError(t, avl.Validate())
+	})
+}
+
+func TestBinaryTree_CloneAndClear(t *testing.T) {
+	tree, err := NewBinaryTree[string]()
+	require.NoError(t, err)
+	values := []string{"apple", "orange", "banana", "grape", "pineapple"}
+	for _, v := range values {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	clone, err := tree.Clone()
+	require.NoError(t, err)
+	require.NoError(t, clone.Validate())
+	assert.Equal(t, tree.Size(), clone.Size())
+	assert.Equal(t, tree.PreOrder(), clone.PreOrder())
+	assert.NotSame(t, tree.root, clone.root)
+
+	// Mutating the clone must not affect the original
+	for _, v := range values[:3] {
+		_, err = clone.Delete(v)
+		require.NoError(t, err)
+	}
+	require.NoError(t, clone.InsertInOrder("kiwi"))
+	assert.Equal(t, 3, clone.Size())
+	assert.Equal(t, len(values), tree.Size())
+	require.NoError(t, tree.Validate())
+	for _, v := range values {
+		node, err := tree.Find(v)
+		require.NoError(t, err)
+		assert.NotNil(t, node, "original lost %s", v)
+	}
+	node, err := tree.Find("kiwi")
+	require.NoError(t, err)
+	assert.Nil(t, node)
+
+	t.Run("clone keeps AVL mode", func(t *testing.T) {
+		avl, err := NewAVLTree[int]()
+		require.NoError(t, err)
+		avlClone, err := avl.Clone()
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, avlClone.InsertInOrder(i))
+		}
+		assert.True(t, avlClone.IsBalanced())
+		assert.Equal(t, 0, avl.Size())
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		tree.Clear()
+		assert.Equal(t, 0, tree.Size())
+		assert.Nil(t, tree.root)
+		_, err := tree.Find("apple")
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+		assert.Equal(t, 3, clone.Size())
+	})
+}
+
+func TestBinaryTree_ContainsAndCount(t *testing.T) {
+	tree, err := NewBinaryTree[string]()
+	require.NoError(t, err)
+
+	found, err := tree.Contains("apple")
+	require.NoError(t, err)
+	assert.False(t, found)
+	count, err := tree.Count("apple")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	for _, v := range []string{"apple", "banana", "apple", "cherry", "apple"} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	found, err = tree.Contains("banana")
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = tree.Contains("grape")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	count, err = tree.Count("apple")
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	count, err = tree.Count("cherry")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = tree.Delete("apple")
+	require.NoError(t, err)
+	count, err = tree.Count("apple")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	t.Run("unsupported type", func(t *testing.T) {
+		anyTree, err := NewBinaryTree[any]()
+		require.NoError(t, err)
+		_, err = anyTree.Contains(true)
+		assert.ErrorIs(t, err, ErrorUnsupportedValueType)
+		_, err = anyTree.Count(true)
+		assert.ErrorIs(t, err, ErrorUnsupportedValueType)
+	})
+
+	t.Run("duplicates in AVL tree", func(t *testing.T) {
+		avl, err := NewAVLTree[int]()
+		require.NoError(t, err)
+		for i := 0; i < 50; i++ {
+			require.NoError(t, avl.InsertInOrder(i%5))
+		}
+		for v := 0; v < 5; v++ {
+			count, err := avl.Count(v)
+			require.NoError(t, err)
+			assert.Equal(t, 10, count)
+		}
+	})
+}
+
+func TestBinaryTree_FindAndDeleteByKey(t *testing.T) {
+	t.Run("empty tree", func(t *testing.T) {
+		tree, err := NewBinaryTree[string]()
+		require.NoError(t, err)
+		_, err = tree.FindByKey(1)
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+		assert.ErrorIs(t, tree.DeleteByKey(1), ErrorNodeIsNil)
+	})
+
+	for _, avl := range []bool{false, true} {
+		name := "unbalanced"
+		if avl {
+			name = "avl"
+		}
+		t.Run(name, func(t *testing.T) {
+			tree, err := NewBinaryTree[string]()
+			if avl {
+				tree, err = NewAVLTree[string]()
+			}
+			require.NoError(t, err)
+			values := []string{"apple", "banana", "cherry", "date", "elderberry"}
+			for _, v := range values {
+				require.NoError(t, tree.InsertInOrder(v))
+			}
+
+			key, err := tree.getHash("cherry")
+			require.NoError(t, err)
+
+			node, err := tree.FindByKey(key)
+			require.NoError(t, err)
+			require.NotNil(t, node)
+			assert.Equal(t, "cherry", node.value)
+
+			node, err = tree.FindByKey(key + 1)
+			require.NoError(t, err)
+			assert.Nil(t, node)
+
+			require.NoError(t, tree.DeleteByKey(key))
+			assert.Equal(t, 4, tree.Size())
+			found, err := tree.Contains("cherry")
+			require.NoError(t, err)
+			assert.False(t, found)
+			require.NoError(t, tree.Validate())
+
+			assert.ErrorIs(t, tree.DeleteByKey(key), ErrorNodeNotFound)
+			assert.Equal(t, 4, tree.Size())
+		})
+	}
+
+	t.Run("key returned by Delete", func(t *testing.T) {
+		tree, err := NewBinaryTree[int]()
+		require.NoError(t, err)
+		for _, v := range []int{5, 3, 8, 3} {
+			require.NoError(t, tree.InsertInOrder(v))
+		}
+
+		key, err := tree.Delete(3)
+		require.NoError(t, err)
+		// The duplicate 3 is still reachable by the cached key
+		node, err := tree.FindByKey(key)
+		require.NoError(t, err)
+		require.NotNil(t, node)
+		assert.Equal(t, 3, node.value)
+		require.NoError(t, tree.DeleteByKey(key))
+		assert.ElementsMatch(t, []int{5, 8}, tree.InOrder())
+	})
+}
+
+func TestBinaryTree_Rotate(t *testing.T) {
+	tree, err := NewBinaryTree[int]()
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, tree.InsertInOrder(i))
+	}
+	before := tree.InOrder()
+
+	rotations := 0
+	for i := 0; i < 20; i++ {
+		node, err := tree.Find(i)
+		require.NoError(t, err)
+		if node.right != nil {
+			_, err = tree.RotateLeft(node)
+			require.NoError(t, err)
+			rotations++
+		}
+		node, err = tree.Find(i)
+		require.NoError(t, err)
+		if node.left != nil {
+			_, err = tree.RotateRight(node)
+			require.NoError(t, err)
+			rotations++
+		}
+	}
+	require.Positive(t, rotations)
+
+	assert.Equal(t, before, tree.InOrder(), "rotations must preserve in-order traversal")
+	assert.NoError(t, tree.Validate())
+	assert.Equal(t, tree.Analyze().Height, tree.Height())
+	for i := 0; i < 20; i++ {
+		found, err := tree.Contains(i)
+		require.NoError(t, err)
+		assert.True(t, found)
+	}
+}
@@ -11,7 +11,7 @@ for consistent key generation and supports any comparable value type.
 - Hash-based key generation using FNV-1a algorithm
 - Thread-safe operations with read-write mutex protection
 - Efficient O(log n) average-case performance for core operations
-- Self-balancing through proper insertion order management
+- Optional AVL self-balancing via NewAVLTree
 - Automatic memory management with garbage collection support
 
 # Performance Characteristics
@@ -61,6 +61,10 @@ Note: Performance depends on hash distribution. Good hash functions provide bala
 	}
 	fmt.Printf("Deleted key: %d\n", deletedKey)
 
+	// Operate directly on a known hash key, skipping the hashing step
+	node, err = tree.FindByKey(deletedKey)
+	err = tree.DeleteByKey(deletedKey)
+
 # Advanced Usage
 
 	// Create a tree for custom types
@@ -101,6 +105,101 @@ Note: Performance depends on hash distribution. Good hash functions provide bala
 		fmt.Printf("Found product: %+v\n", node.Value())
 	}
 
+# Traversal
+
+The tree supports depth-first traversals that return the stored values as a slice:
+
+	inOrder := tree.InOrder()     // left-root-right, ascending hash-key order
+	preOrder := tree.PreOrder()   // root-left-right
+	postOrder := tree.PostOrder() // left-right-root
+
+Iterator walks the tree lazily in in-order with O(h) memory, which avoids
+materializing the whole tree when only the first few values are needed:
+
+	it := tree.Iterator()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		fmt.Println(v)
+	}
+
+LevelOrder performs a breadth-first traversal using the queue package and groups
+values by depth, with the root at level 0:
+
+	for depth, level := range tree.LevelOrder() {
+		fmt.Printf("level %d: %v\n", depth, level)
+	}
+
+# Natural Ordering
+
+Because BinaryTree keys on a hash of the value, its in-order traversal follows hash order.
+For cmp.Ordered values, OrderedBinaryTree uses the value itself as the key so that
+traversals are truly sorted:
+
+	ordered, err := binary_search_tree.NewOrderedBinaryTree[int]()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, v := range []int{50, 30, 70, 20} {
+		_ = ordered.InsertInOrder(v)
+	}
+	fmt.Println(ordered.InOrder()) // [20 30 50 70]
+
+Use BinaryTree for comparable values without a natural ordering.
+
+Every node tracks the size of its subtree, so order-statistic queries run in O(h):
+
+	third, err := ordered.KthSmallest(3) // 1-based: 50
+	rank, err := ordered.Rank(30)        // 2
+
+Range returns every value within inclusive bounds in ascending order, pruning subtrees
+that cannot contain matches:
+
+	fmt.Println(ordered.Range(25, 60)) // [30 50]
+
+# Statistics
+
+Analyze collects the node count, height, minimum and maximum keys, the number of nodes
+with two children, and a validity flag in one traversal. It replaces separate calls to
+Size, Height, Min, Max and Validate, each of which walks the tree.
+
+	stats := tree.Analyze()
+	fmt.Printf("size=%d height=%d full=%d valid=%t\n",
+		stats.Size, stats.Height, stats.FullNodes, stats.Valid)
+
+# Graphviz Export
+
+ToDOT writes the tree as a Graphviz digraph, which makes it easy to check the shape
+produced by AVL rotations and deletions. A node with only one child gets an invisible
+placeholder for the missing child so that the real child is drawn on the correct side.
+
+	f, _ := os.Create("tree.dot")
+	defer f.Close()
+	if err := tree.ToDOT(f); err != nil {
+		log.Fatal(err)
+	}
+	// dot -Tpng tree.dot -o tree.png
+
+# Self-Balancing
+
+Hash keys are effectively random, so a BinaryTree usually stays balanced, but clustered
+or adversarial inputs can degrade it to O(n). NewAVLTree creates a tree that performs
+left/right rotations after every insertion and deletion so that subtree heights differ by at most 1:
+
+	avl, err := binary_search_tree.NewAVLTree[int]()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		_ = avl.InsertInOrder(i)
+	}
+	fmt.Println(avl.Height(), avl.IsBalanced()) // O(log n), true
+
+The same rotations are available as RotateLeft and RotateRight on both tree types, for
+building custom balancing schemes. Each takes a node from Find (or FindByKey), rewires the
+child and parent pointers, and returns the new subtree root; in-order traversal is unchanged:
+
+	node, _ := ordered.Find(30)
+	newRoot, err := ordered.RotateLeft(node) // node's right child takes its place
+
 # Hash Function Details
 
 The tree uses FNV-1a hashing for key generation, which provides:
@@ -152,6 +251,16 @@ The package defines specific errors for different failure conditions:
 - ErrorNodeIsNil: Returned when operating on nil nodes or empty trees
 - ErrorNodeNotFound: Returned when deletion target doesn't exist
 - ErrorUnsupportedValueType: Returned for unsupported hash types
+- ErrorNoSuccessor / ErrorNoPredecessor: Returned when querying past either end of the key order
+- ErrorIndexOutOfRange: Returned by KthSmallest for k outside [1, Size()]
+- ErrorInvalidTree: Returned by Validate when a BST invariant or parent pointer is broken
+- ErrorCannotRotate: Returned by RotateLeft/RotateRight when the node lacks the child to rotate with
+
+Validate is useful in tests to assert that the tree is still well formed after mutations:
+
+	if err := tree.Validate(); err != nil {
+		log.Fatalf("tree corrupted: %v", err)
+	}
 
 Always check for errors when performing tree operations:
 
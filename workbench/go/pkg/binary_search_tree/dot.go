@@ -0,0 +1,47 @@
+package binary_search_tree
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+)
+
+// writeDOT writes the tree rooted at root to w as a Graphviz digraph.
+// Each node is labeled with its value and has edges to its left and right children.
+// When a node has only one child, an invisible placeholder takes the place of the
+// missing child so that Graphviz keeps the real child on the correct side.
+func writeDOT[K cmp.Ordered, V comparable](w io.Writer, root *Node[K, V]) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph BST {")
+	fmt.Fprintln(bw, "\tnode [shape=circle];")
+
+	nextID := 0
+	var visit func(node *Node[K, V]) int
+	visit = func(node *Node[K, V]) int {
+		id := nextID
+		nextID++
+		fmt.Fprintf(bw, "\tn%d [label=%q];\n", id, fmt.Sprint(node.value))
+
+		for _, child := range []*Node[K, V]{node.left, node.right} {
+			if child != nil {
+				childID := visit(child)
+				fmt.Fprintf(bw, "\tn%d -> n%d;\n", id, childID)
+				continue
+			}
+			if node.left == nil && node.right == nil {
+				continue // leaves need no placeholders
+			}
+			fmt.Fprintf(bw, "\tnil%d [shape=point, style=invis];\n", id)
+			fmt.Fprintf(bw, "\tn%d -> nil%d [style=invis];\n", id, id)
+		}
+		return id
+	}
+	if root != nil {
+		visit(root)
+	}
+
+	fmt.Fprintln(bw, "}")
+	// bufio.Writer keeps the first write error, so checking Flush covers every write above.
+	return bw.Flush()
+}
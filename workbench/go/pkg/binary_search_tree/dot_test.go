@@ -0,0 +1,84 @@
+package binary_search_tree
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingWriter is an io.Writer that always fails.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestOrderedBinaryTree_ToDOT(t *testing.T) {
+	t.Run("empty tree", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+
+		var sb strings.Builder
+		require.NoError(t, tree.ToDOT(&sb))
+		assert.Equal(t, "digraph BST {\n\tnode [shape=circle];\n}\n", sb.String())
+	})
+
+	t.Run("nodes and edges", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+		// 50 has two children; 30 has only a right child; 40 and 70 are leaves
+		for _, v := range []int{50, 30, 70, 40} {
+			require.NoError(t, tree.InsertInOrder(v))
+		}
+
+		var sb strings.Builder
+		require.NoError(t, tree.ToDOT(&sb))
+		want := `digraph BST {
+	node [shape=circle];
+	n0 [label="50"];
+	n1 [label="30"];
+	nil1 [shape=point, style=invis];
+	n1 -> nil1 [style=invis];
+	n2 [label="40"];
+	n1 -> n2;
+	n0 -> n1;
+	n3 [label="70"];
+	n0 -> n3;
+}
+`
+		assert.Equal(t, want, sb.String())
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+		require.NoError(t, tree.InsertInOrder(1))
+		assert.Error(t, tree.ToDOT(failingWriter{}))
+	})
+}
+
+func TestBinaryTree_ToDOT(t *testing.T) {
+	tree, err := NewAVLTree[string]()
+	require.NoError(t, err)
+	values := []string{"apple", "banana", "cherry", `say "hi"`}
+	for _, v := range values {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	var sb strings.Builder
+	require.NoError(t, tree.ToDOT(&sb))
+	out := sb.String()
+
+	assert.True(t, strings.HasPrefix(out, "digraph BST {\n"))
+	assert.True(t, strings.HasSuffix(out, "}\n"))
+	for _, v := range values[:3] {
+		assert.Contains(t, out, `[label="`+v+`"]`)
+	}
+	assert.Contains(t, out, `[label="say \"hi\""]`, "labels are escaped")
+	// Every node except the root has exactly one visible incoming edge
+	visibleEdges := strings.Count(out, "->") - strings.Count(out, "[style=invis]")
+	assert.Equal(t, len(values)-1, visibleEdges)
+}
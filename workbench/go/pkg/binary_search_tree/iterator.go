@@ -0,0 +1,53 @@
+package binary_search_tree
+
+import (
+	"cmp"
+	"sync"
+)
+
+// Iterator performs a lazy in-order traversal of a binary search tree.
+// It keeps an explicit stack of the nodes on the current left spine, so memory usage is
+// O(h) rather than O(n), and it is safe to stop iterating at any point.
+//
+// Each call to Next acquires the tree's read lock, so iterating is safe alongside
+// concurrent readers. Mutating the tree while an iterator is in use leaves the rest
+// of the iteration undefined; create a new iterator after modifications.
+type Iterator[K cmp.Ordered, V comparable] struct {
+	stack []*Node[K, V]
+	mu    *sync.RWMutex
+}
+
+// newIterator creates an Iterator positioned before the smallest key of the subtree rooted at root.
+// This function assumes the caller already holds the read lock guarded by mu.
+func newIterator[K cmp.Ordered, V comparable](root *Node[K, V], height int, mu *sync.RWMutex) *Iterator[K, V] {
+	it := &Iterator[K, V]{
+		stack: make([]*Node[K, V], 0, max(height+1, 0)),
+		mu:    mu,
+	}
+	it.pushLeft(root)
+	return it
+}
+
+// Next returns the next value in ascending key order and true,
+// or the zero value and false once the traversal is exhausted.
+func (it *Iterator[K, V]) Next() (V, bool) {
+	it.mu.RLock()
+	defer it.mu.RUnlock()
+
+	if len(it.stack) == 0 {
+		var zero V
+		return zero, false
+	}
+	node := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	it.pushLeft(node.right)
+	return node.value, true
+}
+
+// pushLeft pushes node and all of its left descendants onto the stack.
+func (it *Iterator[K, V]) pushLeft(node *Node[K, V]) {
+	for node != nil {
+		it.stack = append(it.stack, node)
+		node = node.left
+	}
+}
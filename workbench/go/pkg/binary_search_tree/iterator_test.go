@@ -0,0 +1,61 @@
+package binary_search_tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectIterator[K int | uint64, V comparable](it *Iterator[K, V]) []V {
+	var values []V
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestIterator(t *testing.T) {
+	t.Run("empty tree", func(t *testing.T) {
+		tree, err := NewBinaryTree[string]()
+		require.NoError(t, err)
+		it := tree.Iterator()
+		v, ok := it.Next()
+		assert.False(t, ok)
+		assert.Zero(t, v)
+	})
+
+	t.Run("matches InOrder", func(t *testing.T) {
+		tree, err := NewBinaryTree[string]()
+		require.NoError(t, err)
+		for _, v := range []string{"apple", "orange", "banana", "grape", "pineapple"} {
+			require.NoError(t, tree.InsertInOrder(v))
+		}
+		assert.Equal(t, tree.InOrder(), collectIterator(tree.Iterator()))
+	})
+
+	t.Run("ordered tree yields sorted values", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+		for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 30} {
+			require.NoError(t, tree.InsertInOrder(v))
+		}
+		assert.Equal(t, []int{20, 30, 30, 40, 50, 60, 70, 80}, collectIterator(tree.Iterator()))
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+		for _, v := range []int{4, 2, 6, 1, 3, 5, 7} {
+			require.NoError(t, tree.InsertInOrder(v))
+		}
+
+		it := tree.Iterator()
+		assert.Len(t, it.stack, tree.Height()+1, "only the left spine is materialized")
+		for want := 1; want <= 3; want++ {
+			v, ok := it.Next()
+			require.True(t, ok)
+			assert.Equal(t, want, v)
+		}
+	})
+}
@@ -4,6 +4,9 @@ package binary_search_tree
 import (
 	"cmp"
 	"errors"
+	"fmt"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/queue"
 )
 
 // ErrorNodeIsNil is returned when an operation is attempted on a nil Node.
@@ -12,6 +15,22 @@ var ErrorNodeIsNil = errors.New("node is nil")
 // ErrorNodeNotFound is returned when a delete operation cannot find the target node.
 var ErrorNodeNotFound = errors.New("node not found")
 
+// ErrorNoSuccessor is returned when the queried node is the last node in key order.
+var ErrorNoSuccessor = errors.New("node has no successor")
+
+// ErrorNoPredecessor is returned when the queried node is the first node in key order.
+var ErrorNoPredecessor = errors.New("node has no predecessor")
+
+// ErrorInvalidTree is returned by Validate when a binary search tree invariant is violated.
+var ErrorInvalidTree = errors.New("invalid binary search tree")
+
+// ErrorIndexOutOfRange is returned when a selection index is outside the tree's size.
+var ErrorIndexOutOfRange = errors.New("index out of range")
+
+// ErrorCannotRotate is returned when a rotation is requested on a node that lacks the child
+// which would become the new subtree root.
+var ErrorCannotRotate = errors.New("node has no child to rotate with")
+
 // Node represents a node in a binary search tree.
 // It holds a generic key `K` that must be an ordered type, and a generic value `V`
 // that must be a comparable type.
@@ -21,13 +40,20 @@ type Node[K cmp.Ordered, V comparable] struct {
 	parent *Node[K, V]
 	left   *Node[K, V]
 	right  *Node[K, V]
+	// subtreeHeight caches the height of the subtree rooted at this node (0 for a leaf).
+	// It is maintained on insert and delete and used by AVL rebalancing.
+	subtreeHeight int
+	// subtreeSize caches the number of nodes in the subtree rooted at this node (1 for a leaf).
+	// It is maintained on insert and delete and used for selection and rank queries.
+	subtreeSize int
 }
 
 // NewNode creates and returns a new Node with the given key and value.
 func NewNode[K cmp.Ordered, V comparable](key K, value V) *Node[K, V] {
 	return &Node[K, V]{
-		key:   key,
-		value: value,
+		key:         key,
+		value:       value,
+		subtreeSize: 1,
 	}
 }
 
@@ -53,49 +79,83 @@ func (node *Node[K, V]) insertInOrder(key K, value V) error {
 			err = node.right.insertInOrder(key, value)
 		}
 	}
+	node.refresh()
 	return err
 }
 
+// insertBalanced inserts a new node like insertInOrder and then rebalances every node
+// on the path back to the root using AVL rotations.
+// It returns the new root of the subtree, which may differ from the current node after a rotation.
+func (node *Node[K, V]) insertBalanced(key K, value V) *Node[K, V] {
+	if node == nil {
+		return NewNode(key, value)
+	}
+
+	if key <= node.key {
+		node.updateChild(node.left.insertBalanced(key, value), true)
+	} else {
+		node.updateChild(node.right.insertBalanced(key, value), false)
+	}
+	return node.rebalance()
+}
+
 // delete removes a node with the specified key and value from the binary search tree.
 // It performs a recursive search and deletion while maintaining BST properties and parent relationships.
 // The method handles four cases:
 // 1. Target is in left subtree (key < node.key)
 // 2. Target is in right subtree (key > node.key)
-// 3. Same key but different value (searches left subtree for duplicates)
+// 3. Same key but different value (searches left subtree for duplicates, then the right
+// subtree where AVL rotations may have moved them)
 // 4. Exact match found (delegates to deleteCurrentNode for removal)
+// If balance is true, every node on the path back to the root is rebalanced using AVL rotations.
 // Returns the new root of the subtree after deletion and ErrorNodeNotFound if target not found.
-func (node *Node[K, V]) delete(key K, value V) (*Node[K, V], error) {
+func (node *Node[K, V]) delete(key K, value V, balance bool) (*Node[K, V], error) {
 	if node == nil {
 		return nil, ErrorNodeNotFound
 	}
 
+	var updated *Node[K, V]
 	switch {
 	case key < node.key:
-		newLeft, err := node.left.delete(key, value)
+		newLeft, err := node.left.delete(key, value, balance)
 		if err != nil {
 			return nil, err
 		}
-		return node.updateChild(newLeft, true), nil
+		updated = node.updateChild(newLeft, true)
 
 	case key > node.key:
-		newRight, err := node.right.delete(key, value)
+		newRight, err := node.right.delete(key, value, balance)
 		if err != nil {
 			return nil, err
 		}
-		return node.updateChild(newRight, false), nil
+		updated = node.updateChild(newRight, false)
 
 	case node.value != value:
 		// Same key but different value, search in left subtree
-		newLeft, err := node.left.delete(key, value)
+		newLeft, err := node.left.delete(key, value, balance)
+		if err == nil {
+			updated = node.updateChild(newLeft, true)
+			break
+		}
+		newRight, err := node.right.delete(key, value, balance)
 		if err != nil {
 			return nil, err
 		}
-		return node.updateChild(newLeft, true), nil
+		updated = node.updateChild(newRight, false)
 
 	default:
 		// Found the node to delete (key == node.key && value == node.value)
-		return node.deleteCurrentNode()
+		var err error
+		updated, err = node.deleteCurrentNode(balance)
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	if balance && updated != nil {
+		return updated.rebalance(), nil
+	}
+	return updated, nil
 }
 
 // updateChild updates either the left or right child of the current node and maintains parent relationships.
@@ -111,16 +171,166 @@ func (node *Node[K, V]) updateChild(child *Node[K, V], isLeft bool) *Node[K, V]
 	if child != nil {
 		child.parent = node
 	}
+	node.refresh()
 	return node
 }
 
+// refresh recomputes the cached subtree height and size of the current node from its children.
+func (node *Node[K, V]) refresh() {
+	node.subtreeHeight = max(node.left.cachedHeight(), node.right.cachedHeight()) + 1
+	node.subtreeSize = node.left.cachedSize() + node.right.cachedSize() + 1
+}
+
+// cachedSize returns the cached subtree size of the current node, or 0 for a nil node.
+func (node *Node[K, V]) cachedSize() int {
+	if node == nil {
+		return 0
+	}
+	return node.subtreeSize
+}
+
+// selectKth returns the node holding the k-th smallest key (1-based) in the subtree
+// rooted at the current node, or nil if k is out of range.
+// It uses the cached subtree sizes to descend in O(h).
+func (node *Node[K, V]) selectKth(k int) *Node[K, V] {
+	current := node
+	for current != nil {
+		leftSize := current.left.cachedSize()
+		switch {
+		case k <= leftSize:
+			current = current.left
+		case k == leftSize+1:
+			return current
+		default:
+			k -= leftSize + 1
+			current = current.right
+		}
+	}
+	return nil
+}
+
+// countLess returns the number of nodes in the subtree rooted at the current node
+// whose key is strictly less than key, using the cached subtree sizes in O(h).
+func (node *Node[K, V]) countLess(key K) int {
+	count := 0
+	current := node
+	for current != nil {
+		if key <= current.key {
+			current = current.left
+		} else {
+			count += current.left.cachedSize() + 1
+			current = current.right
+		}
+	}
+	return count
+}
+
+// cachedHeight returns the cached subtree height of the current node, or -1 for a nil node.
+func (node *Node[K, V]) cachedHeight() int {
+	if node == nil {
+		return -1
+	}
+	return node.subtreeHeight
+}
+
+// balanceFactor returns the difference between the cached heights of the left and right subtrees.
+func (node *Node[K, V]) balanceFactor() int {
+	return node.left.cachedHeight() - node.right.cachedHeight()
+}
+
+// rotateLeft rotates the subtree rooted at the current node to the left and
+// returns the new subtree root (the former right child).
+// The left, right and parent pointers and cached heights are rewired; the caller is
+// responsible for attaching the returned node to the former parent.
+// If the current node has no right child, it is returned unchanged.
+func (node *Node[K, V]) rotateLeft() *Node[K, V] {
+	if node == nil || node.right == nil {
+		return node
+	}
+	pivot := node.right
+	pivot.parent = node.parent
+	node.updateChild(pivot.left, false)
+	pivot.updateChild(node, true)
+	return pivot
+}
+
+// rotateRight rotates the subtree rooted at the current node to the right and
+// returns the new subtree root (the former left child).
+// The left, right and parent pointers and cached heights are rewired; the caller is
+// responsible for attaching the returned node to the former parent.
+// If the current node has no left child, it is returned unchanged.
+func (node *Node[K, V]) rotateRight() *Node[K, V] {
+	if node == nil || node.left == nil {
+		return node
+	}
+	pivot := node.left
+	pivot.parent = node.parent
+	node.updateChild(pivot.right, true)
+	pivot.updateChild(node, false)
+	return pivot
+}
+
+// rotateInTree performs a left (or right, if left is false) rotation at node inside the tree
+// whose root is root, and returns the tree's new root together with the new subtree root.
+// Unlike rotateLeft and rotateRight, it attaches the new subtree root to the former parent
+// (or makes it the tree root) and refreshes the cached heights of all ancestors.
+// It returns ErrorNodeIsNil if node is nil, or ErrorCannotRotate if node lacks the child
+// that would take its place.
+func rotateInTree[K cmp.Ordered, V comparable](root, node *Node[K, V], left bool) (*Node[K, V], *Node[K, V], error) {
+	if node == nil {
+		return root, nil, ErrorNodeIsNil
+	}
+	if (left && node.right == nil) || (!left && node.left == nil) {
+		return root, nil, ErrorCannotRotate
+	}
+
+	parent := node.parent
+	isLeftChild := parent != nil && parent.left == node
+	var pivot *Node[K, V]
+	if left {
+		pivot = node.rotateLeft()
+	} else {
+		pivot = node.rotateRight()
+	}
+
+	if parent == nil {
+		pivot.parent = nil
+		return pivot, pivot, nil
+	}
+	parent.updateChild(pivot, isLeftChild)
+	for ancestor := parent.parent; ancestor != nil; ancestor = ancestor.parent {
+		ancestor.refresh()
+	}
+	return root, pivot, nil
+}
+
+// rebalance restores the AVL property at the current node, assuming both subtrees are
+// already balanced, and returns the new subtree root.
+// It performs a single or double rotation when the subtree heights differ by more than 1.
+func (node *Node[K, V]) rebalance() *Node[K, V] {
+	switch factor := node.balanceFactor(); {
+	case factor > 1:
+		if node.left.balanceFactor() < 0 {
+			node.updateChild(node.left.rotateLeft(), true)
+		}
+		return node.rotateRight()
+	case factor < -1:
+		if node.right.balanceFactor() > 0 {
+			node.updateChild(node.right.rotateRight(), false)
+		}
+		return node.rotateLeft()
+	default:
+		return node
+	}
+}
+
 // deleteCurrentNode handles the deletion of the current node when it matches the target key and value.
 // It implements the three standard BST deletion cases:
 // 1. Node with no children (leaf): simply return nil
 // 2. Node with one child: return the child to replace this node
 // 3. Node with two children: replace with in-order successor and delete the successor
 // This method assumes the current node is the target to be deleted.
-func (node *Node[K, V]) deleteCurrentNode() (*Node[K, V], error) {
+func (node *Node[K, V]) deleteCurrentNode(balance bool) (*Node[K, V], error) {
 	// Case 1: No left child
 	if node.left == nil {
 		return node.right, nil
@@ -142,7 +352,7 @@ func (node *Node[K, V]) deleteCurrentNode() (*Node[K, V], error) {
 	node.value = successor.value
 
 	// Delete the successor from right subtree
-	newRight, err := node.right.delete(successor.key, successor.value)
+	newRight, err := node.right.delete(successor.key, successor.value, balance)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +376,58 @@ func (node *Node[K, V]) findMin() (*Node[K, V], error) {
 	return current, nil
 }
 
+// findMax finds and returns the node with the maximum key in the subtree rooted at this node.
+// It traverses right children until reaching the rightmost node.
+// Returns ErrorNodeIsNil if called on a nil node.
+func (node *Node[K, V]) findMax() (*Node[K, V], error) {
+	if node == nil {
+		return nil, ErrorNodeIsNil
+	}
+	current := node
+	for current.right != nil {
+		current = current.right
+	}
+	return current, nil
+}
+
+// successor returns the next node in key order using the parent pointers,
+// or nil if the current node is the last one.
+// If the node has a right subtree, the successor is its minimum;
+// otherwise it is the first ancestor reached from a left child.
+func (node *Node[K, V]) successor() *Node[K, V] {
+	if node == nil {
+		return nil
+	}
+	if node.right != nil {
+		next, _ := node.right.findMin()
+		return next
+	}
+	current := node
+	for current.parent != nil && current.parent.right == current {
+		current = current.parent
+	}
+	return current.parent
+}
+
+// predecessor returns the previous node in key order using the parent pointers,
+// or nil if the current node is the first one.
+// If the node has a left subtree, the predecessor is its maximum;
+// otherwise it is the first ancestor reached from a right child.
+func (node *Node[K, V]) predecessor() *Node[K, V] {
+	if node == nil {
+		return nil
+	}
+	if node.left != nil {
+		prev, _ := node.left.findMax()
+		return prev
+	}
+	current := node
+	for current.parent != nil && current.parent.left == current {
+		current = current.parent
+	}
+	return current.parent
+}
+
 // setLeftChild attaches a node as the left child of the current node.
 // It also sets the parent of the left child to the current node.
 func (node *Node[K, V]) setLeftChild(left *Node[K, V]) error {
@@ -174,6 +436,7 @@ func (node *Node[K, V]) setLeftChild(left *Node[K, V]) error {
 	}
 	node.left = left
 	left.parent = node
+	node.refresh()
 	return nil
 }
 
@@ -185,6 +448,7 @@ func (node *Node[K, V]) setRightChild(right *Node[K, V]) error {
 	}
 	node.right = right
 	right.parent = node
+	node.refresh()
 	return nil
 }
 
@@ -209,5 +473,215 @@ func (node *Node[K, V]) find(key K, value V) *Node[K, V] {
 
 	// Since insertInOrder places equal keys in the left subtree,
 	// we continue the search there for other nodes with the same key.
-	return node.left.find(key, value)
+	// AVL rotations may move equal keys to the right subtree, so it is searched as a fallback.
+	if found := node.left.find(key, value); found != nil {
+		return found
+	}
+	return node.right.find(key, value)
+}
+
+// findKey searches for a node with the given key in the subtree rooted at the current node,
+// regardless of its value. It returns the first match on the search path, or nil if none exists.
+func (node *Node[K, V]) findKey(key K) *Node[K, V] {
+	for node != nil {
+		switch {
+		case key < node.key:
+			node = node.left
+		case key > node.key:
+			node = node.right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// inOrder appends the values of the subtree rooted at the current node to values
+// in left-root-right order, which is ascending key order, and returns the extended slice.
+func (node *Node[K, V]) inOrder(values []V) []V {
+	if node == nil {
+		return values
+	}
+	values = node.left.inOrder(values)
+	values = append(values, node.value)
+	return node.right.inOrder(values)
+}
+
+// preOrder appends the values of the subtree rooted at the current node to values
+// in root-left-right order and returns the extended slice.
+func (node *Node[K, V]) preOrder(values []V) []V {
+	if node == nil {
+		return values
+	}
+	values = append(values, node.value)
+	values = node.left.preOrder(values)
+	return node.right.preOrder(values)
+}
+
+// postOrder appends the values of the subtree rooted at the current node to values
+// in left-right-root order and returns the extended slice.
+func (node *Node[K, V]) postOrder(values []V) []V {
+	if node == nil {
+		return values
+	}
+	values = node.left.postOrder(values)
+	values = node.right.postOrder(values)
+	return append(values, node.value)
+}
+
+// height returns the number of edges on the longest path from the current node to a leaf.
+// A single node has height 0 and a nil node has height -1.
+func (node *Node[K, V]) height() int {
+	if node == nil {
+		return -1
+	}
+	return max(node.left.height(), node.right.height()) + 1
+}
+
+// isBalanced reports whether, for every node in the subtree rooted at the current node,
+// the heights of the left and right subtrees differ by at most 1.
+func (node *Node[K, V]) isBalanced() bool {
+	_, balanced := node.checkBalance()
+	return balanced
+}
+
+// checkBalance computes the height of the subtree rooted at the current node and
+// whether it is balanced in a single post-order pass.
+func (node *Node[K, V]) checkBalance() (int, bool) {
+	if node == nil {
+		return -1, true
+	}
+	leftHeight, leftBalanced := node.left.checkBalance()
+	if !leftBalanced {
+		return 0, false
+	}
+	rightHeight, rightBalanced := node.right.checkBalance()
+	if !rightBalanced {
+		return 0, false
+	}
+	diff := leftHeight - rightHeight
+	if diff < -1 || diff > 1 {
+		return 0, false
+	}
+	return max(leftHeight, rightHeight) + 1, true
+}
+
+// levelOrder returns the values of the subtree rooted at the current node grouped by depth,
+// where the current node is level 0. It performs a breadth-first traversal using
+// a queue.Queue as the frontier; capacity must be at least the number of nodes in the subtree.
+// A nil node returns an empty slice.
+func (node *Node[K, V]) levelOrder(capacity int) [][]V {
+	levels := [][]V{}
+	if node == nil {
+		return levels
+	}
+
+	frontier := queue.NewQueue[*Node[K, V]](capacity)
+	// The queue is sized for every node, so Enqueue cannot overflow.
+	_ = frontier.Enqueue(node)
+	for !frontier.IsEmpty() {
+		width := frontier.Count()
+		level := make([]V, 0, width)
+		for range width {
+			current, _ := frontier.Dequeue()
+			level = append(level, current.value)
+			if current.left != nil {
+				_ = frontier.Enqueue(current.left)
+			}
+			if current.right != nil {
+				_ = frontier.Enqueue(current.right)
+			}
+		}
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+// rangeValues appends, in ascending key order, the values of all nodes in the subtree
+// rooted at the current node whose key lies in [lo, hi], and returns the extended slice.
+// Subtrees that cannot contain keys in the range are pruned.
+func (node *Node[K, V]) rangeValues(lo, hi K, values []V) []V {
+	if node == nil {
+		return values
+	}
+	// Equal keys may live in the left subtree, so it is visited whenever lo <= node.key.
+	if lo <= node.key {
+		values = node.left.rangeValues(lo, hi, values)
+	}
+	if lo <= node.key && node.key <= hi {
+		values = append(values, node.value)
+	}
+	if node.key < hi {
+		values = node.right.rangeValues(lo, hi, values)
+	}
+	return values
+}
+
+// count returns the number of nodes in the subtree rooted at the current node
+// that hold the given key and value.
+// Duplicates are placed in the left subtree by insertInOrder, and AVL rotations may move
+// them to the right, so both subtrees of an equal-key node are searched.
+func (node *Node[K, V]) count(key K, value V) int {
+	if node == nil {
+		return 0
+	}
+	if key < node.key {
+		return node.left.count(key, value)
+	}
+	if key > node.key {
+		return node.right.count(key, value)
+	}
+	matches := node.left.count(key, value) + node.right.count(key, value)
+	if node.value == value {
+		matches++
+	}
+	return matches
+}
+
+// validate checks the binary search tree invariants of the subtree rooted at the current node.
+// Every key must be <= upper (if set) and > lower (if set; >= when allowEqualRight is true,
+// since AVL rotations may move equal keys into the right subtree), and every node's parent
+// pointer must equal parent. It returns the number of nodes in the subtree, or an error
+// wrapping ErrorInvalidTree that identifies the first violating node in pre-order.
+func (node *Node[K, V]) validate(parent *Node[K, V], lower, upper *K, allowEqualRight bool) (int, error) {
+	if node == nil {
+		return 0, nil
+	}
+	if node.parent != parent {
+		return 0, fmt.Errorf("%w: node with key %v has an inconsistent parent pointer", ErrorInvalidTree, node.key)
+	}
+	if upper != nil && node.key > *upper {
+		return 0, fmt.Errorf("%w: node with key %v is in the left subtree of key %v", ErrorInvalidTree, node.key, *upper)
+	}
+	if lower != nil && (node.key < *lower || (node.key == *lower && !allowEqualRight)) {
+		return 0, fmt.Errorf("%w: node with key %v is in the right subtree of key %v", ErrorInvalidTree, node.key, *lower)
+	}
+
+	leftCount, err := node.left.validate(node, lower, &node.key, allowEqualRight)
+	if err != nil {
+		return 0, err
+	}
+	rightCount, err := node.right.validate(node, &node.key, upper, allowEqualRight)
+	if err != nil {
+		return 0, err
+	}
+	return leftCount + rightCount + 1, nil
+}
+
+// clone returns a deep copy of the subtree rooted at the current node.
+// Every node is newly allocated and the parent pointer of the copy's root is set to parent.
+func (node *Node[K, V]) clone(parent *Node[K, V]) *Node[K, V] {
+	if node == nil {
+		return nil
+	}
+	copied := &Node[K, V]{
+		key:           node.key,
+		value:         node.value,
+		parent:        parent,
+		subtreeHeight: node.subtreeHeight,
+		subtreeSize:   node.subtreeSize,
+	}
+	copied.left = node.left.clone(copied)
+	copied.right = node.right.clone(copied)
+	return copied
 }
@@ -172,3 +172,357 @@ func TestFind_Node(t *testing.T) {
 		assert.Nil(t, foundNode)
 	})
 }
+
+func TestTraversal_Node(t *testing.T) {
+	//        20
+	//       /  \
+	//     10    30
+	//    /  \     \
+	//   5   15     40
+	root := NewNode(20, "twenty")
+	require.NoError(t, root.insertInOrder(10, "ten"))
+	require.NoError(t, root.insertInOrder(30, "thirty"))
+	require.NoError(t, root.insertInOrder(5, "five"))
+	require.NoError(t, root.insertInOrder(15, "fifteen"))
+	require.NoError(t, root.insertInOrder(40, "forty"))
+
+	assert.Equal(t, []string{"five", "ten", "fifteen", "twenty", "thirty", "forty"}, root.inOrder(nil))
+	assert.Equal(t, []string{"twenty", "ten", "five", "fifteen", "thirty", "forty"}, root.preOrder(nil))
+	assert.Equal(t, []string{"five", "fifteen", "ten", "forty", "thirty", "twenty"}, root.postOrder(nil))
+
+	t.Run("nil node", func(t *testing.T) {
+		var node *Node[int, string]
+		assert.Empty(t, node.inOrder(nil))
+		assert.Empty(t, node.preOrder(nil))
+		assert.Empty(t, node.postOrder(nil))
+	})
+}
+
+func TestHeightAndBalance_Node(t *testing.T) {
+	t.Run("nil node", func(t *testing.T) {
+		var node *Node[int, string]
+		assert.Equal(t, -1, node.height())
+		assert.True(t, node.isBalanced())
+	})
+
+	t.Run("single node", func(t *testing.T) {
+		node := NewNode(1, "one")
+		assert.Equal(t, 0, node.height())
+		assert.True(t, node.isBalanced())
+	})
+
+	t.Run("balanced tree", func(t *testing.T) {
+		root := NewNode(20, "twenty")
+		for _, k := range []int{10, 30, 5, 15, 40} {
+			require.NoError(t, root.insertInOrder(k, ""))
+		}
+		assert.Equal(t, 2, root.height())
+		assert.True(t, root.isBalanced())
+	})
+
+	t.Run("degenerate tree", func(t *testing.T) {
+		root := NewNode(1, "one")
+		for _, k := range []int{2, 3, 4} {
+			require.NoError(t, root.insertInOrder(k, ""))
+		}
+		assert.Equal(t, 3, root.height())
+		assert.False(t, root.isBalanced())
+	})
+
+	t.Run("unbalanced subtree below balanced root heights", func(t *testing.T) {
+		//        20
+		//      /    \
+		//    10      30
+		//   /          \
+		//  5            40
+		// /               \
+		// 1                50
+		root := NewNode(20, "")
+		for _, k := range []int{10, 30, 5, 40, 1, 50} {
+			require.NoError(t, root.insertInOrder(k, ""))
+		}
+		assert.Equal(t, 3, root.height())
+		assert.False(t, root.isBalanced())
+	})
+}
+
+func TestSuccessorPredecessor_Node(t *testing.T) {
+	root := NewNode(20, "twenty")
+	for _, k := range []int{10, 30, 5, 15, 25, 40, 12} {
+		require.NoError(t, root.insertInOrder(k, ""))
+	}
+	sorted := []int{5, 10, 12, 15, 20, 25, 30, 40}
+
+	minNode, err := root.findMin()
+	require.NoError(t, err)
+	assert.Equal(t, 5, minNode.key)
+	maxNode, err := root.findMax()
+	require.NoError(t, err)
+	assert.Equal(t, 40, maxNode.key)
+
+	// Walk forward from min and backward from max using parent pointers
+	var forward, backward []int
+	for n := minNode; n != nil; n = n.successor() {
+		forward = append(forward, n.key)
+	}
+	for n := maxNode; n != nil; n = n.predecessor() {
+		backward = append([]int{n.key}, backward...)
+	}
+	assert.Equal(t, sorted, forward)
+	assert.Equal(t, sorted, backward)
+
+	t.Run("nil node", func(t *testing.T) {
+		var node *Node[int, string]
+		_, err := node.findMax()
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+		assert.Nil(t, node.successor())
+		assert.Nil(t, node.predecessor())
+	})
+}
+
+func TestLevelOrder_Node(t *testing.T) {
+	root := NewNode(20, "twenty")
+	for _, kv := range []struct {
+		key   int
+		value string
+	}{{10, "ten"}, {30, "thirty"}, {5, "five"}, {15, "fifteen"}, {40, "forty"}} {
+		require.NoError(t, root.insertInOrder(kv.key, kv.value))
+	}
+
+	assert.Equal(t, [][]string{
+		{"twenty"},
+		{"ten", "thirty"},
+		{"five", "fifteen", "forty"},
+	}, root.levelOrder(6))
+
+	var empty *Node[int, string]
+	assert.Empty(t, empty.levelOrder(0))
+}
+
+func TestInsertBalanced_Node(t *testing.T) {
+	t.Run("monotonically increasing keys", func(t *testing.T) {
+		var root *Node[int, int]
+		for k := 1; k <= 1000; k++ {
+			root = root.insertBalanced(k, k)
+			root.parent = nil
+		}
+
+		assert.True(t, root.isBalanced())
+		assert.Equal(t, root.height(), root.subtreeHeight, "cached height must match actual height")
+		// An AVL tree has height < 1.44 * log2(n + 2)
+		assert.LessOrEqual(t, root.height(), 14)
+
+		keys := root.inOrder(nil)
+		require.Len(t, keys, 1000)
+		for i, k := range keys {
+			assert.Equal(t, i+1, k)
+		}
+	})
+
+	t.Run("left-right and right-left cases", func(t *testing.T) {
+		var root *Node[int, string]
+		for _, k := range []int{30, 10, 20} { // left-right
+			root = root.insertBalanced(k, "")
+		}
+		assert.Equal(t, 20, root.key)
+		assert.Equal(t, 10, root.left.key)
+		assert.Equal(t, 30, root.right.key)
+		assert.Equal(t, root, root.left.parent)
+		assert.Equal(t, root, root.right.parent)
+
+		root = nil
+		for _, k := range []int{10, 30, 20} { // right-left
+			root = root.insertBalanced(k, "")
+		}
+		assert.Equal(t, 20, root.key)
+		assert.Equal(t, 10, root.left.key)
+		assert.Equal(t, 30, root.right.key)
+	})
+
+	t.Run("delete rebalances", func(t *testing.T) {
+		var root *Node[int, int]
+		for k := 1; k <= 100; k++ {
+			root = root.insertBalanced(k, k)
+		}
+		var err error
+		for k := 1; k <= 60; k++ {
+			root, err = root.delete(k, k, true)
+			require.NoError(t, err)
+			assert.True(t, root.isBalanced(), "unbalanced after deleting %d", k)
+		}
+		assert.Len(t, root.inOrder(nil), 40)
+	})
+}
+
+func TestSubtreeSize_Node(t *testing.T) {
+	root := NewNode(50, "")
+	assert.Equal(t, 1, root.subtreeSize)
+	for _, k := range []int{30, 70, 20, 40, 60, 80, 35} {
+		require.NoError(t, root.insertInOrder(k, ""))
+	}
+	assert.Equal(t, 8, root.subtreeSize)
+	assert.Equal(t, 4, root.left.subtreeSize)
+	assert.Equal(t, 3, root.right.subtreeSize)
+
+	// Delete a node with two children through the successor path
+	root, err := root.delete(30, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, 7, root.subtreeSize)
+	assert.Equal(t, 35, root.left.key)
+	assert.Equal(t, 3, root.left.subtreeSize)
+
+	for k := 1; k <= 7; k++ {
+		node := root.selectKth(k)
+		require.NotNil(t, node)
+		assert.Equal(t, k-1, root.countLess(node.key))
+	}
+	assert.Nil(t, root.selectKth(0))
+	assert.Nil(t, root.selectKth(8))
+}
+
+func TestRotate_Node(t *testing.T) {
+	// buildSubtree returns the fixed tree
+	//
+	//	      100
+	//	      /
+	//	    50
+	//	   /  \
+	//	  30   70
+	//	      /  \
+	//	     60   80
+	buildSubtree := func(t *testing.T) *Node[int, string] {
+		root := NewNode(100, "")
+		for _, k := range []int{50, 30, 70, 60, 80} {
+			require.NoError(t, root.insertInOrder(k, ""))
+		}
+		return root
+	}
+
+	t.Run("rotateLeft rewires children and parents", func(t *testing.T) {
+		root := buildSubtree(t)
+		x := root.left
+		y := x.right
+
+		pivot := x.rotateLeft()
+		require.Same(t, y, pivot)
+		assert.Equal(t, 50, pivot.left.key)
+		assert.Equal(t, 80, pivot.right.key)
+		assert.Equal(t, 30, x.left.key)
+		assert.Equal(t, 60, x.right.key)
+		assert.Same(t, root, pivot.parent, "pivot inherits the former parent")
+		assert.Same(t, pivot, x.parent)
+		assert.Same(t, x, x.right.parent)
+		assert.Same(t, pivot, pivot.right.parent)
+		assert.Equal(t, 1, x.subtreeHeight)
+		assert.Equal(t, 3, x.subtreeSize)
+		assert.Equal(t, 2, pivot.subtreeHeight)
+		assert.Equal(t, 5, pivot.subtreeSize)
+	})
+
+	t.Run("rotateRight undoes rotateLeft", func(t *testing.T) {
+		root := buildSubtree(t)
+		x := root.left
+		pivot := x.rotateLeft()
+		back := pivot.rotateRight()
+		require.Same(t, x, back)
+		assert.Equal(t, 30, x.left.key)
+		assert.Equal(t, 70, x.right.key)
+		assert.Equal(t, 60, x.right.left.key)
+		assert.Equal(t, 80, x.right.right.key)
+		assert.Same(t, x, x.right.parent)
+		assert.Same(t, x.right, x.right.left.parent)
+		assert.Same(t, root, x.parent)
+	})
+
+	t.Run("rotation without the needed child is a no-op", func(t *testing.T) {
+		leaf := NewNode(1, "")
+		assert.Same(t, leaf, leaf.rotateLeft())
+		assert.Same(t, leaf, leaf.rotateRight())
+	})
+
+	t.Run("rotateInTree attaches to the parent and refreshes ancestors", func(t *testing.T) {
+		root := buildSubtree(t)
+		newRoot, pivot, err := rotateInTree(root, root.left, true)
+		require.NoError(t, err)
+		assert.Same(t, root, newRoot)
+		assert.Same(t, pivot, root.left)
+		assert.Equal(t, 70, pivot.key)
+		assert.Equal(t, 3, root.subtreeHeight)
+		assert.Equal(t, 6, root.subtreeSize)
+		assert.Equal(t, []string{"", "", "", "", "", ""}, root.inOrder(nil))
+
+		count, err := root.validate(nil, nil, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, 6, count)
+	})
+
+	t.Run("rotateInTree at the root replaces the root", func(t *testing.T) {
+		root := buildSubtree(t)
+		newRoot, pivot, err := rotateInTree(root, root, false)
+		require.NoError(t, err)
+		assert.Same(t, pivot, newRoot)
+		assert.Equal(t, 50, newRoot.key)
+		assert.Nil(t, newRoot.parent)
+		assert.Equal(t, 100, newRoot.right.key)
+		assert.Equal(t, 70, newRoot.right.left.key)
+		assert.Equal(t, 3, newRoot.subtreeHeight)
+	})
+
+	t.Run("rotateInTree errors", func(t *testing.T) {
+		root := buildSubtree(t)
+		_, _, err := rotateInTree(root, nil, true)
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+		_, _, err = rotateInTree(root, root, true)
+		assert.ErrorIs(t, err, ErrorCannotRotate, "root has no right child")
+		_, _, err = rotateInTree(root, root.left.left, false)
+		assert.ErrorIs(t, err, ErrorCannotRotate, "leaf has no left child")
+	})
+}
+
+func TestValidate_Node(t *testing.T) {
+	newTree := func() *Node[int, string] {
+		root := NewNode(20, "")
+		for _, k := range []int{10, 30, 5, 15, 25, 40} {
+			require.NoError(t, root.insertInOrder(k, ""))
+		}
+		return root
+	}
+
+	t.Run("valid tree", func(t *testing.T) {
+		count, err := newTree().validate(nil, nil, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, 7, count)
+	})
+
+	t.Run("nil node", func(t *testing.T) {
+		count, err := (*Node[int, string])(nil).validate(nil, nil, nil, false)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("key too large in left subtree", func(t *testing.T) {
+		root := newTree()
+		root.left.right.key = 21
+		_, err := root.validate(nil, nil, nil, false)
+		assert.ErrorIs(t, err, ErrorInvalidTree)
+		assert.Contains(t, err.Error(), "key 21")
+	})
+
+	t.Run("equal key in right subtree", func(t *testing.T) {
+		root := newTree()
+		root.right.left.key = 20
+		_, err := root.validate(nil, nil, nil, false)
+		assert.ErrorIs(t, err, ErrorInvalidTree)
+
+		_, err = root.validate(nil, nil, nil, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("inconsistent parent pointer", func(t *testing.T) {
+		root := newTree()
+		root.right.right.parent = root
+		_, err := root.validate(nil, nil, nil, false)
+		assert.ErrorIs(t, err, ErrorInvalidTree)
+		assert.Contains(t, err.Error(), "key 40")
+	})
+}
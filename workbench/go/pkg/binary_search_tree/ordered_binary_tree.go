@@ -0,0 +1,415 @@
+package binary_search_tree
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// OrderedBinaryTree represents a thread-safe binary search tree keyed on the values themselves.
+// Unlike BinaryTree, no hashing is involved: values are placed using their natural ordering,
+// so in-order traversal yields truly sorted values and range queries become possible.
+type OrderedBinaryTree[V cmp.Ordered] struct {
+	root *Node[V, V]
+	size int
+	mu   sync.RWMutex
+}
+
+// NewOrderedBinaryTree creates and returns a new empty OrderedBinaryTree.
+// The tree is initialized with no root node and zero size.
+func NewOrderedBinaryTree[V cmp.Ordered]() (*OrderedBinaryTree[V], error) {
+	return &OrderedBinaryTree[V]{
+		root: nil,
+		size: 0,
+	}, nil
+}
+
+// Size returns the total number of nodes currently in the tree.
+// This method is thread-safe.
+func (tree *OrderedBinaryTree[V]) Size() int {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.size
+}
+
+// InsertInOrder inserts a new value into the binary search tree using the value as its own key.
+// Duplicate values are placed in the left subtree.
+// The tree's size is incremented on successful insertion.
+// This method is thread-safe.
+func (tree *OrderedBinaryTree[V]) InsertInOrder(value V) error {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	if tree.root == nil {
+		tree.root = NewNode(value, value)
+		tree.size++
+		return nil
+	}
+
+	err := tree.root.insertInOrder(value, value)
+	if err == nil {
+		tree.size++
+	}
+	return err
+}
+
+// Delete removes a node with the specified value from the binary search tree.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// If the value is not found, it returns ErrorNodeNotFound and doesn't modify the tree.
+// The tree's size is decremented only on successful deletion.
+// This method is thread-safe.
+func (tree *OrderedBinaryTree[V]) Delete(value V) error {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	if tree.root == nil {
+		return ErrorNodeIsNil
+	}
+
+	_root, err := tree.root.delete(value, value, false)
+	if err != nil {
+		return err
+	}
+	tree.root = _root
+	if tree.root != nil {
+		tree.root.parent = nil
+	}
+	tree.size--
+	return nil
+}
+
+// RotateLeft rotates the subtree rooted at node to the left and returns the new subtree root,
+// the former right child of node. The left, right and parent pointers and the cached subtree
+// heights are rewired, and the new subtree root takes node's place under its parent
+// (or becomes the tree root). In-order traversal is unchanged, so it can be used to build
+// custom balancing on top of Find.
+// node must belong to this tree. It returns ErrorNodeIsNil if node is nil, or
+// ErrorCannotRotate if node has no right child.
+// This method is thread-safe.
+func (tree *OrderedBinaryTree[V]) RotateLeft(node *Node[V, V]) (*Node[V, V], error) {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	root, pivot, err := rotateInTree(tree.root, node, true)
+	if err != nil {
+		return nil, err
+	}
+	tree.root = root
+	return pivot, nil
+}
+
+// RotateRight rotates the subtree rooted at node to the right and returns the new subtree root,
+// the former left child of node. The left, right and parent pointers and the cached subtree
+// heights are rewired, and the new subtree root takes node's place under its parent
+// (or becomes the tree root). In-order traversal is unchanged, so it can be used to build
+// custom balancing on top of Find.
+// node must belong to this tree. It returns ErrorNodeIsNil if node is nil, or
+// ErrorCannotRotate if node has no left child.
+// If the left child has a key equal to node's, node ends up in its right subtree; Find still
+// locates such nodes, but Validate reports ErrorInvalidTree.
+// This method is thread-safe.
+func (tree *OrderedBinaryTree[V]) RotateRight(node *Node[V, V]) (*Node[V, V], error) {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	root, pivot, err := rotateInTree(tree.root, node, false)
+	if err != nil {
+		return nil, err
+	}
+	tree.root = root
+	return pivot, nil
+}
+
+// Find searches for a node with the given value in the tree.
+// It returns a pointer to the found Node or nil if the value is not found.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Find(value V) (*Node[V, V], error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	if tree.root == nil {
+		return nil, ErrorNodeIsNil
+	}
+	return tree.root.find(value, value), nil
+}
+
+// InOrder returns all values in the tree in ascending order using a left-root-right traversal.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) InOrder() []V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.inOrder(make([]V, 0, tree.size))
+}
+
+// PreOrder returns all values in the tree using a root-left-right traversal.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) PreOrder() []V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.preOrder(make([]V, 0, tree.size))
+}
+
+// PostOrder returns all values in the tree using a left-right-root traversal.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) PostOrder() []V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.postOrder(make([]V, 0, tree.size))
+}
+
+// LevelOrder returns all values in the tree grouped by depth using a breadth-first traversal.
+// The root is level 0, and values within a level are ordered from left to right.
+// An empty tree returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) LevelOrder() [][]V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.levelOrder(tree.size)
+}
+
+// Height returns the number of edges on the longest root-to-leaf path.
+// A tree with a single node has height 0 and an empty tree has height -1.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Height() int {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.height()
+}
+
+// IsBalanced reports whether the heights of the left and right subtrees of every node
+// differ by at most 1. An empty tree is balanced.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) IsBalanced() bool {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.isBalanced()
+}
+
+// Min returns the value with the smallest key in the tree.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Min() (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	node, err := tree.root.findMin()
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	return node.value, nil
+}
+
+// Max returns the value with the largest key in the tree.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Max() (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	node, err := tree.root.findMax()
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	return node.value, nil
+}
+
+// Successor returns the value that follows the given value in key order.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// If the value is not present, it returns ErrorNodeNotFound.
+// If the value is the last one in key order, it returns ErrorNoSuccessor.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Successor(value V) (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	var zero V
+	node, err := tree.lookup(value)
+	if err != nil {
+		return zero, err
+	}
+	next := node.successor()
+	if next == nil {
+		return zero, ErrorNoSuccessor
+	}
+	return next.value, nil
+}
+
+// Predecessor returns the value that precedes the given value in key order.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// If the value is not present, it returns ErrorNodeNotFound.
+// If the value is the first one in key order, it returns ErrorNoPredecessor.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Predecessor(value V) (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	var zero V
+	node, err := tree.lookup(value)
+	if err != nil {
+		return zero, err
+	}
+	prev := node.predecessor()
+	if prev == nil {
+		return zero, ErrorNoPredecessor
+	}
+	return prev.value, nil
+}
+
+// KthSmallest returns the k-th smallest value in the tree, where k is 1-based
+// (k == 1 returns the minimum). Duplicate values each occupy their own position.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// If k is outside [1, Size()], it returns ErrorIndexOutOfRange.
+// It runs in O(h) using the subtree sizes maintained on every node.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) KthSmallest(k int) (V, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	var zero V
+	if tree.root == nil {
+		return zero, ErrorNodeIsNil
+	}
+	if k < 1 || k > tree.size {
+		return zero, ErrorIndexOutOfRange
+	}
+	return tree.root.selectKth(k).value, nil
+}
+
+// Rank returns the 1-based position of value in ascending order, i.e. one more than
+// the number of values strictly less than it. For duplicates the position of the first
+// occurrence is returned, so KthSmallest(Rank(v)) == v.
+// If the tree is empty, it returns ErrorNodeIsNil.
+// If the value is not present, it returns ErrorNodeNotFound.
+// It runs in O(h) using the subtree sizes maintained on every node.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Rank(value V) (int, error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	if _, err := tree.lookup(value); err != nil {
+		return 0, err
+	}
+	return tree.root.countLess(value) + 1, nil
+}
+
+// Range returns, in ascending order, every value v in the tree with lo <= v <= hi.
+// Subtrees that cannot contain matching values are pruned, so the cost is O(h + m)
+// where m is the number of returned values. If lo > hi, the result is empty.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Range(lo, hi V) []V {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.rangeValues(lo, hi, []V{})
+}
+
+// Validate checks that the tree satisfies the binary search tree invariants:
+// for every node, keys in the left subtree are <= its key and keys in the right subtree
+// are > its key (matching insertInOrder's tie-breaking), every parent pointer is consistent,
+// and the number of reachable nodes equals Size().
+// It returns nil for a valid tree, or an error wrapping ErrorInvalidTree that describes
+// the first violating node.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Validate() error {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	count, err := tree.root.validate(nil, nil, nil, false)
+	if err != nil {
+		return err
+	}
+	if count != tree.size {
+		return fmt.Errorf("%w: found %d nodes but size is %d", ErrorInvalidTree, count, tree.size)
+	}
+	return nil
+}
+
+// Analyze returns the tree's size, height, minimum and maximum keys, number of nodes
+// with two children, and validity in a single O(n) traversal.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Analyze() TreeStats[V] {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return analyzeTree(tree.root, tree.size, false)
+}
+
+// ToDOT writes the tree to w as a Graphviz digraph, labeling each node with its value.
+// Render it with, for example, `dot -Tpng tree.dot -o tree.png`.
+// It returns the first error encountered while writing to w.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) ToDOT(w io.Writer) error {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return writeDOT(w, tree.root)
+}
+
+// Clone returns an independent deep copy of the tree.
+// All nodes are newly allocated with rebuilt parent pointers, so mutating the clone
+// does not affect the original tree and vice versa.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Clone() (*OrderedBinaryTree[V], error) {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+
+	return &OrderedBinaryTree[V]{
+		root: tree.root.clone(nil),
+		size: tree.size,
+	}, nil
+}
+
+// Clear removes all nodes from the tree by dropping the root and resetting the size to 0.
+// This method is thread-safe.
+func (tree *OrderedBinaryTree[V]) Clear() {
+	tree.mu.Lock()
+	defer tree.mu.Unlock()
+
+	tree.root = nil
+	tree.size = 0
+}
+
+// Contains reports whether the tree holds the given value.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Contains(value V) bool {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.find(value, value) != nil
+}
+
+// Count returns how many times the given value has been inserted.
+// Since duplicates are inserted into the left subtree, it walks every node with an equal key.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Count(value V) int {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return tree.root.count(value, value)
+}
+
+// Iterator returns a cursor that lazily yields the tree's values in in-order (ascending key) order.
+// Only the nodes on the current path are kept, so memory usage is O(h) and iteration can stop early.
+// The tree must not be modified while the iterator is in use.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (tree *OrderedBinaryTree[V]) Iterator() *Iterator[V, V] {
+	tree.mu.RLock()
+	defer tree.mu.RUnlock()
+	return newIterator(tree.root, tree.root.cachedHeight(), &tree.mu)
+}
+
+// lookup finds the node holding value without locking.
+// It returns ErrorNodeIsNil for an empty tree and ErrorNodeNotFound if the value is not present.
+// This method assumes the caller already holds the appropriate lock.
+func (tree *OrderedBinaryTree[V]) lookup(value V) (*Node[V, V], error) {
+	if tree.root == nil {
+		return nil, ErrorNodeIsNil
+	}
+	node := tree.root.find(value, value)
+	if node == nil {
+		return nil, ErrorNodeNotFound
+	}
+	return node, nil
+}
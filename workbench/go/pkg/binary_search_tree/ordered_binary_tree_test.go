@@ -0,0 +1,370 @@
+package binary_search_tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrderedBinaryTree(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	require.NotNil(t, tree)
+	assert.Nil(t, tree.root)
+	assert.Equal(t, 0, tree.Size())
+}
+
+func TestOrderedBinaryTree_InsertAndFind(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+
+	values := []int{50, 30, 70, 20, 40, 60, 80}
+	for i, v := range values {
+		require.NoError(t, tree.InsertInOrder(v))
+		assert.Equal(t, i+1, tree.Size())
+	}
+
+	require.NotNil(t, tree.root)
+	assert.Equal(t, 50, tree.root.key)
+	assert.Equal(t, 30, tree.root.left.key)
+	assert.Equal(t, 70, tree.root.right.key)
+
+	for _, v := range values {
+		node, err := tree.Find(v)
+		require.NoError(t, err)
+		require.NotNil(t, node)
+		assert.Equal(t, v, node.value)
+	}
+
+	node, err := tree.Find(99)
+	require.NoError(t, err)
+	assert.Nil(t, node)
+
+	t.Run("find in empty tree", func(t *testing.T) {
+		empty, err := NewOrderedBinaryTree[string]()
+		require.NoError(t, err)
+		_, err = empty.Find("anything")
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+	})
+}
+
+func TestOrderedBinaryTree_Delete(t *testing.T) {
+	t.Run("delete from empty tree", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+		assert.ErrorIs(t, tree.Delete(1), ErrorNodeIsNil)
+	})
+
+	t.Run("delete nonexistent value", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+		require.NoError(t, tree.InsertInOrder(1))
+		assert.ErrorIs(t, tree.Delete(2), ErrorNodeNotFound)
+		assert.Equal(t, 1, tree.Size())
+	})
+
+	t.Run("delete keeps sorted order", func(t *testing.T) {
+		tree, err := NewOrderedBinaryTree[int]()
+		require.NoError(t, err)
+		for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+			require.NoError(t, tree.InsertInOrder(v))
+		}
+
+		require.NoError(t, tree.Delete(50)) // root with two children
+		require.NoError(t, tree.Delete(20)) // leaf
+		require.NoError(t, tree.Delete(70)) // node with two children
+
+		assert.Equal(t, 4, tree.Size())
+		assert.Equal(t, []int{30, 40, 60, 80}, tree.InOrder())
+		assert.Nil(t, tree.root.parent)
+	})
+}
+
+func TestOrderedBinaryTree_Traversal(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[string]()
+	require.NoError(t, err)
+	assert.Empty(t, tree.InOrder())
+
+	for _, v := range []string{"mango", "apple", "zucchini", "banana", "apple"} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	assert.Equal(t, []string{"apple", "apple", "banana", "mango", "zucchini"}, tree.InOrder())
+	assert.Equal(t, []string{"mango", "apple", "apple", "banana", "zucchini"}, tree.PreOrder())
+	assert.Equal(t, []string{"apple", "banana", "apple", "zucchini", "mango"}, tree.PostOrder())
+}
+
+func TestOrderedBinaryTree_HeightAndBalance(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	assert.Equal(t, -1, tree.Height())
+	assert.True(t, tree.IsBalanced())
+
+	for _, v := range []int{4, 2, 6, 1, 3, 5, 7} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+	assert.Equal(t, 2, tree.Height())
+	assert.True(t, tree.IsBalanced())
+
+	// Increasing insertions degenerate into a linked list
+	for v := 8; v <= 12; v++ {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+	assert.Equal(t, 7, tree.Height())
+	assert.False(t, tree.IsBalanced())
+}
+
+func TestOrderedBinaryTree_MinMaxSuccessorPredecessor(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+
+	t.Run("empty tree", func(t *testing.T) {
+		_, err := tree.Min()
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+		_, err = tree.Max()
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+		_, err = tree.Successor(1)
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+		_, err = tree.Predecessor(1)
+		assert.ErrorIs(t, err, ErrorNodeIsNil)
+	})
+
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	minValue, err := tree.Min()
+	require.NoError(t, err)
+	assert.Equal(t, 20, minValue)
+	maxValue, err := tree.Max()
+	require.NoError(t, err)
+	assert.Equal(t, 80, maxValue)
+
+	testCases := []struct {
+		value       int
+		successor   int
+		predecessor int
+	}{
+		{30, 40, 20},
+		{40, 50, 30},
+		{50, 60, 40},
+		{60, 70, 50},
+	}
+	for _, tc := range testCases {
+		next, err := tree.Successor(tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.successor, next)
+		prev, err := tree.Predecessor(tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.predecessor, prev)
+	}
+
+	_, err = tree.Successor(80)
+	assert.ErrorIs(t, err, ErrorNoSuccessor)
+	_, err = tree.Predecessor(20)
+	assert.ErrorIs(t, err, ErrorNoPredecessor)
+	_, err = tree.Successor(55)
+	assert.ErrorIs(t, err, ErrorNodeNotFound)
+	_, err = tree.Predecessor(55)
+	assert.ErrorIs(t, err, ErrorNodeNotFound)
+
+	t.Run("after deleting the root", func(t *testing.T) {
+		require.NoError(t, tree.Delete(50))
+		next, err := tree.Successor(40)
+		require.NoError(t, err)
+		assert.Equal(t, 60, next)
+	})
+}
+
+func TestOrderedBinaryTree_LevelOrder(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{}, tree.LevelOrder())
+
+	for _, v := range []int{4, 2, 6, 1, 3, 5, 7, 8} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+	assert.Equal(t, [][]int{{4}, {2, 6}, {1, 3, 5, 7}, {8}}, tree.LevelOrder())
+}
+
+func TestOrderedBinaryTree_KthSmallestAndRank(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+
+	_, err = tree.KthSmallest(1)
+	assert.ErrorIs(t, err, ErrorNodeIsNil)
+	_, err = tree.Rank(1)
+	assert.ErrorIs(t, err, ErrorNodeIsNil)
+
+	values := []int{50, 30, 70, 20, 40, 60, 80, 30}
+	for _, v := range values {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+	sorted := []int{20, 30, 30, 40, 50, 60, 70, 80}
+
+	for k := 1; k <= len(sorted); k++ {
+		v, err := tree.KthSmallest(k)
+		require.NoError(t, err)
+		assert.Equal(t, sorted[k-1], v)
+	}
+	for _, k := range []int{0, -1, len(sorted) + 1} {
+		_, err = tree.KthSmallest(k)
+		assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+	}
+
+	rankCases := map[int]int{20: 1, 30: 2, 40: 4, 50: 5, 80: 8}
+	for v, want := range rankCases {
+		rank, err := tree.Rank(v)
+		require.NoError(t, err)
+		assert.Equal(t, want, rank, "rank of %d", v)
+	}
+	_, err = tree.Rank(55)
+	assert.ErrorIs(t, err, ErrorNodeNotFound)
+
+	t.Run("consistent after deletions", func(t *testing.T) {
+		require.NoError(t, tree.Delete(50)) // two children, successor path
+		require.NoError(t, tree.Delete(20)) // leaf
+		remaining := []int{30, 30, 40, 60, 70, 80}
+		for k, want := range remaining {
+			v, err := tree.KthSmallest(k + 1)
+			require.NoError(t, err)
+			assert.Equal(t, want, v)
+		}
+		rank, err := tree.Rank(60)
+		require.NoError(t, err)
+		assert.Equal(t, 4, rank)
+	})
+}
+
+func TestOrderedBinaryTree_Range(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	assert.Equal(t, []int{}, tree.Range(0, 100))
+
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 40, 35} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	testCases := []struct {
+		name     string
+		lo, hi   int
+		expected []int
+	}{
+		{"whole tree", 0, 100, []int{20, 30, 35, 40, 40, 50, 60, 70, 80}},
+		{"inclusive bounds", 30, 60, []int{30, 35, 40, 40, 50, 60}},
+		{"duplicates at bound", 40, 40, []int{40, 40}},
+		{"between values", 41, 49, []int{}},
+		{"below all", 0, 10, []int{}},
+		{"above all", 90, 100, []int{}},
+		{"inverted bounds", 60, 30, []int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tree.Range(tc.lo, tc.hi))
+		})
+	}
+}
+
+func TestOrderedBinaryTree_Validate(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	assert.NoError(t, tree.Validate())
+
+	for _, v := range []int{50, 30, 70, 20, 40, 60, 80, 30, 30} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+	require.NoError(t, tree.Validate())
+
+	for _, v := range []int{50, 30, 80, 30} {
+		require.NoError(t, tree.Delete(v))
+		require.NoError(t, tree.Validate(), "invalid after deleting %d", v)
+	}
+
+	tree.root.left.key = 100
+	assert.ErrorIs(t, tree.Validate(), ErrorInvalidTree)
+}
+
+func TestOrderedBinaryTree_CloneAndClear(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	for _, v := range []int{50, 30, 70, 20, 40} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	clone, err := tree.Clone()
+	require.NoError(t, err)
+	require.NoError(t, clone.Delete(30))
+	require.NoError(t, clone.Validate())
+
+	assert.Equal(t, []int{20, 40, 50, 70}, clone.InOrder())
+	assert.Equal(t, []int{20, 30, 40, 50, 70}, tree.InOrder())
+	rank, err := tree.Rank(70)
+	require.NoError(t, err)
+	assert.Equal(t, 5, rank)
+
+	tree.Clear()
+	assert.Equal(t, 0, tree.Size())
+	assert.Empty(t, tree.InOrder())
+	assert.Equal(t, 4, clone.Size())
+}
+
+func TestOrderedBinaryTree_ContainsAndCount(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	assert.False(t, tree.Contains(1))
+	assert.Zero(t, tree.Count(1))
+
+	for _, v := range []int{5, 3, 5, 8, 5, 1} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+	assert.True(t, tree.Contains(8))
+	assert.False(t, tree.Contains(4))
+	assert.Equal(t, 3, tree.Count(5))
+	assert.Equal(t, 1, tree.Count(3))
+	assert.Zero(t, tree.Count(4))
+}
+
+func TestOrderedBinaryTree_Rotate(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	// Inserting sorted values produces a right-leaning chain of height 4.
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, tree.InsertInOrder(i))
+	}
+	assert.Equal(t, 4, tree.Height())
+
+	root, err := tree.Find(1)
+	require.NoError(t, err)
+	pivot, err := tree.RotateLeft(root)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pivot.key)
+	assert.Equal(t, 3, tree.Height())
+
+	node, err := tree.Find(3)
+	require.NoError(t, err)
+	pivot, err = tree.RotateLeft(node)
+	require.NoError(t, err)
+	assert.Equal(t, 4, pivot.key)
+	assert.Equal(t, 2, tree.Height())
+	assert.True(t, tree.IsBalanced())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, tree.InOrder())
+	assert.Equal(t, [][]int{{2}, {1, 4}, {3, 5}}, tree.LevelOrder())
+	assert.NoError(t, tree.Validate())
+
+	root, err = tree.Find(2)
+	require.NoError(t, err)
+	pivot, err = tree.RotateRight(root)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pivot.key)
+	assert.Equal(t, [][]int{{1}, {2}, {4}, {3, 5}}, tree.LevelOrder())
+	assert.NoError(t, tree.Validate())
+
+	leaf, err := tree.Find(5)
+	require.NoError(t, err)
+	_, err = tree.RotateLeft(leaf)
+	assert.ErrorIs(t, err, ErrorCannotRotate)
+	_, err = tree.RotateRight(nil)
+	assert.ErrorIs(t, err, ErrorNodeIsNil)
+	assert.Equal(t, 5, tree.Size())
+}
@@ -0,0 +1,64 @@
+package binary_search_tree
+
+import (
+	"cmp"
+)
+
+// TreeStats summarizes the shape and validity of a binary search tree.
+// It is computed by a single traversal, so it is cheaper than calling Size, Height,
+// Min, Max and Validate separately.
+type TreeStats[K cmp.Ordered] struct {
+	// Size is the number of nodes reachable from the root.
+	Size int
+	// Height is the number of edges on the longest root-to-leaf path (-1 for an empty tree).
+	Height int
+	// MinKey and MaxKey are the smallest and largest keys in the tree.
+	// They are the zero value for an empty tree.
+	MinKey K
+	MaxKey K
+	// FullNodes is the number of nodes with both a left and a right child.
+	// In a well-balanced tree it is close to Size/2; in a degenerate (list-shaped) tree it is 0.
+	FullNodes int
+	// Valid reports whether the tree satisfies the same invariants that Validate checks.
+	Valid bool
+}
+
+// analyze accumulates statistics for the subtree rooted at the current node into stats
+// and returns the subtree's height. It checks the same ordering and parent-pointer
+// invariants as validate, but keeps traversing after a violation so that the other
+// statistics are still complete; stats.Valid is cleared on the first violation.
+func (node *Node[K, V]) analyze(parent *Node[K, V], lower, upper *K, allowEqualRight bool, stats *TreeStats[K]) int {
+	if node == nil {
+		return -1
+	}
+	if node.parent != parent ||
+		(upper != nil && node.key > *upper) ||
+		(lower != nil && (node.key < *lower || (node.key == *lower && !allowEqualRight))) {
+		stats.Valid = false
+	}
+
+	if stats.Size == 0 || node.key < stats.MinKey {
+		stats.MinKey = node.key
+	}
+	if stats.Size == 0 || node.key > stats.MaxKey {
+		stats.MaxKey = node.key
+	}
+	stats.Size++
+	if node.left != nil && node.right != nil {
+		stats.FullNodes++
+	}
+
+	leftHeight := node.left.analyze(node, lower, &node.key, allowEqualRight, stats)
+	rightHeight := node.right.analyze(node, &node.key, upper, allowEqualRight, stats)
+	return max(leftHeight, rightHeight) + 1
+}
+
+// analyzeTree computes the statistics of the tree rooted at root, whose recorded size is size.
+func analyzeTree[K cmp.Ordered, V comparable](root *Node[K, V], size int, allowEqualRight bool) TreeStats[K] {
+	stats := TreeStats[K]{Valid: true}
+	stats.Height = root.analyze(nil, nil, nil, allowEqualRight, &stats)
+	if stats.Size != size {
+		stats.Valid = false
+	}
+	return stats
+}
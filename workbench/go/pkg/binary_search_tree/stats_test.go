@@ -0,0 +1,104 @@
+package binary_search_tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderedBinaryTree_Analyze(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   TreeStats[int]
+	}{
+		{
+			name:   "empty tree",
+			values: nil,
+			want:   TreeStats[int]{Size: 0, Height: -1, Valid: true},
+		},
+		{
+			name:   "single node",
+			values: []int{42},
+			want:   TreeStats[int]{Size: 1, Height: 0, MinKey: 42, MaxKey: 42, Valid: true},
+		},
+		{
+			name:   "complete tree",
+			values: []int{50, 30, 70, 20, 40, 60, 80},
+			want:   TreeStats[int]{Size: 7, Height: 2, MinKey: 20, MaxKey: 80, FullNodes: 3, Valid: true},
+		},
+		{
+			name:   "degenerate tree",
+			values: []int{1, 2, 3, 4, 5},
+			want:   TreeStats[int]{Size: 5, Height: 4, MinKey: 1, MaxKey: 5, FullNodes: 0, Valid: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := NewOrderedBinaryTree[int]()
+			require.NoError(t, err)
+			for _, v := range tt.values {
+				require.NoError(t, tree.InsertInOrder(v))
+			}
+
+			stats := tree.Analyze()
+			assert.Equal(t, tt.want, stats)
+
+			// Analyze agrees with the individual queries
+			assert.Equal(t, tree.Size(), stats.Size)
+			assert.Equal(t, tree.Height(), stats.Height)
+			assert.Equal(t, tree.Validate() == nil, stats.Valid)
+			if stats.Size > 0 {
+				minValue, err := tree.Min()
+				require.NoError(t, err)
+				maxValue, err := tree.Max()
+				require.NoError(t, err)
+				assert.Equal(t, minValue, stats.MinKey)
+				assert.Equal(t, maxValue, stats.MaxKey)
+			}
+		})
+	}
+}
+
+func TestOrderedBinaryTree_Analyze_Invalid(t *testing.T) {
+	tree, err := NewOrderedBinaryTree[int]()
+	require.NoError(t, err)
+	for _, v := range []int{50, 30, 70} {
+		require.NoError(t, tree.InsertInOrder(v))
+	}
+
+	// Break the ordering invariant directly
+	tree.root.left.key = 90
+	stats := tree.Analyze()
+	assert.False(t, stats.Valid)
+	assert.Equal(t, 3, stats.Size, "other statistics are still collected")
+	assert.Equal(t, 90, stats.MaxKey)
+	assert.Error(t, tree.Validate())
+
+	// A size mismatch is also reported
+	tree.root.left.key = 30
+	tree.size = 4
+	assert.False(t, tree.Analyze().Valid)
+}
+
+func TestBinaryTree_Analyze(t *testing.T) {
+	for _, avl := range []bool{false, true} {
+		tree, err := NewBinaryTree[int]()
+		if avl {
+			tree, err = NewAVLTree[int]()
+		}
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, tree.InsertInOrder(i%30))
+		}
+
+		stats := tree.Analyze()
+		assert.True(t, stats.Valid)
+		assert.Equal(t, 100, stats.Size)
+		assert.Equal(t, tree.Height(), stats.Height)
+		assert.LessOrEqual(t, stats.MinKey, stats.MaxKey)
+		assert.Positive(t, stats.FullNodes)
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Package cache provides a generic least-recently-used (LRU) cache.
+
+The cache keeps at most a fixed number of entries. Every Get hit and every Put marks
+the entry as most recently used, and when a Put would exceed the capacity the least
+recently used entry is evicted.
+
+# Design
+
+The cache combines two structures:
+
+  - A linked_list.LinkedList ordered by recency, with the most recently used entry at the
+    head and the eviction candidate at the tail. Entries are moved to the front with
+    DeleteNode and InsertBefore, both O(1) given the node.
+  - A Go map from key to list node for O(1) lookup. The hash_table package stores plain
+    values rather than key/value pairs, so it cannot map a key to its list node.
+
+# Performance Characteristics
+
+- Get: O(1)
+- Put: O(1)
+- Len/Capacity: O(1)
+- Space: O(capacity)
+
+# Thread Safety
+
+The cache is thread-safe. Because Get updates the recency order, every operation
+acquires an exclusive lock.
+
+# Basic Usage
+
+	lru := cache.NewLRU[string, int](2)
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Get("a")    // 1, true; "a" is now most recently used
+	lru.Put("c", 3) // evicts "b"
+	lru.Get("b")    // 0, false
+*/
+package cache
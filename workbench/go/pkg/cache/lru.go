@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"sync"
+
+	l "github.com/haru-256/ctci-6th-edition/pkg/linked_list"
+)
+
+// entry is a key/value pair stored in the recency list.
+// Entries are stored by pointer so that list values stay comparable for any V.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRU is a fixed-capacity cache that evicts the least recently used entry when full.
+// The zero value is not ready to use; use NewLRU to create a new cache.
+type LRU[K comparable, V any] struct {
+	// recency orders entries from most recently used (head) to least recently used (tail)
+	recency *l.LinkedList[*entry[K, V]]
+	// nodes maps each key to its node in recency
+	nodes map[K]*l.Node[*entry[K, V]]
+	// capacity is the maximum number of entries the cache holds
+	capacity int
+	mu       sync.Mutex
+}
+
+// NewLRU creates and returns an empty LRU cache that holds at most capacity entries.
+// The capacity must be greater than 0, otherwise the function will panic.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity <= 0 {
+		panic("cache: capacity must be greater than 0")
+	}
+	return &LRU[K, V]{
+		recency:  l.NewLinkedList[*entry[K, V]](),
+		nodes:    make(map[K]*l.Node[*entry[K, V]], capacity),
+		capacity: capacity,
+	}
+}
+
+// Get returns the value stored for key and true, marking the entry as most recently used.
+// If the key is not cached, it returns the zero value and false.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	node, ok := c.nodes[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.moveToFront(node)
+	return node.Value.value, true
+}
+
+// Put stores value for key and marks the entry as most recently used.
+// If the key is already cached its value is replaced; otherwise a new entry is added
+// and, if the cache is over capacity, the least recently used entry is evicted.
+func (c *LRU[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if node, ok := c.nodes[key]; ok {
+		node.Value.value = value
+		c.moveToFront(node)
+		return
+	}
+
+	c.recency.Prepend(&entry[K, V]{key: key, value: value})
+	c.nodes[key] = c.recency.Head()
+
+	if c.recency.Len() > c.capacity {
+		oldest := c.recency.Tail()
+		// oldest is the tail of recency, so it is always linked into the list.
+		_ = c.recency.DeleteNode(oldest)
+		delete(c.nodes, oldest.Value.key)
+	}
+}
+
+// Len returns the number of entries currently cached.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.recency.Len()
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// moveToFront relinks node at the head of the recency list and updates the key index.
+// InsertBefore allocates a new node, so the index must point at the new head afterwards.
+// This method assumes the caller already holds the lock.
+func (c *LRU[K, V]) moveToFront(node *l.Node[*entry[K, V]]) {
+	head := c.recency.Head()
+	if head == node {
+		return
+	}
+	// node is not the head, so the list still has a head after node is removed.
+	_ = c.recency.DeleteNode(node)
+	_ = c.recency.InsertBefore(node.Value, head)
+	c.nodes[node.Value.key] = c.recency.Head()
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recencyKeys returns the cached keys from most to least recently used.
+func recencyKeys[K comparable, V any](c *LRU[K, V]) []K {
+	var keys []K
+	for node := c.recency.Head(); node != nil; node = node.Next {
+		keys = append(keys, node.Value.key)
+	}
+	return keys
+}
+
+func TestNewLRU(t *testing.T) {
+	c := NewLRU[string, int](2)
+	require.NotNil(t, c)
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, 2, c.Capacity())
+
+	_, ok := c.Get("missing")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { NewLRU[string, int](0) })
+}
+
+func TestLRU_GetPut(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	assert.Equal(t, []string{"b", "a"}, recencyKeys(c))
+
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []string{"a", "b"}, recencyKeys(c), "Get should move the entry to the front")
+
+	// "b" is least recently used and gets evicted
+	c.Put("c", 3)
+	assert.Equal(t, 2, c.Len())
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"c", "a"}, recencyKeys(c))
+
+	// Updating an existing key replaces the value without evicting
+	c.Put("a", 10)
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, []string{"a", "c"}, recencyKeys(c))
+	v, ok = c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 10, v)
+
+	c.Put("d", 4)
+	_, ok = c.Get("c")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"d", "a"}, recencyKeys(c))
+}
+
+func TestLRU_CapacityOne(t *testing.T) {
+	c := NewLRU[int, string](1)
+	c.Put(1, "one")
+	v, ok := c.Get(1)
+	require.True(t, ok)
+	assert.Equal(t, "one", v)
+
+	c.Put(2, "two")
+	_, ok = c.Get(1)
+	assert.False(t, ok)
+	v, ok = c.Get(2)
+	require.True(t, ok)
+	assert.Equal(t, "two", v)
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestLRU_NonComparableValues(t *testing.T) {
+	c := NewLRU[string, []int](2)
+	c.Put("primes", []int{2, 3, 5})
+	v, ok := c.Get("primes")
+	require.True(t, ok)
+	assert.Equal(t, []int{2, 3, 5}, v)
+}
+
+func TestLRU_Concurrency(t *testing.T) {
+	c := NewLRU[string, int](50)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				key := fmt.Sprintf("key-%d", (g*100+i)%80)
+				c.Put(key, i)
+				c.Get(key)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, c.Len())
+	assert.Len(t, c.nodes, 50)
+	assert.Len(t, recencyKeys(c), 50)
+}
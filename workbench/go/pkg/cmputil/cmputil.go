@@ -0,0 +1,27 @@
+package cmputil
+
+import "cmp"
+
+// Comparator compares the values pointed to by a and b.
+// It returns a positive value if a should come first, a negative value if b should
+// come first, and zero if they are equivalent.
+type Comparator[T any] = func(a, b *T) int
+
+// Max orders larger values first, which makes a max heap when passed to heap.NewHeap.
+// NaN compares as smaller than every other float, as with cmp.Compare.
+func Max[T cmp.Ordered](a, b *T) int {
+	return cmp.Compare(*a, *b)
+}
+
+// Min orders smaller values first, which makes a min heap when passed to heap.NewHeap.
+// NaN compares as smaller than every other float, as with cmp.Compare.
+func Min[T cmp.Ordered](a, b *T) int {
+	return cmp.Compare(*b, *a)
+}
+
+// Reverse returns a comparator that orders values in the opposite order of c.
+func Reverse[T any](c Comparator[T]) Comparator[T] {
+	return func(a, b *T) int {
+		return c(b, a)
+	}
+}
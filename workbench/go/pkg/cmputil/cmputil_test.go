@@ -0,0 +1,48 @@
+package cmputil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestMax(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int
+		want int
+	}{
+		{"greater first", 2, 1, 1},
+		{"smaller second", 1, 2, -1},
+		{"equal", 3, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Max(&tt.a, &tt.b))
+			assert.Equal(t, -tt.want, Min(&tt.a, &tt.b))
+		})
+	}
+
+	assert.Positive(t, Max(ptr("b"), ptr("a")))
+	assert.Negative(t, Min(ptr("b"), ptr("a")))
+	assert.Positive(t, Max(ptr(1.5), ptr(math.NaN())), "NaN is smaller than every number")
+	assert.Zero(t, Max(ptr(math.NaN()), ptr(math.NaN())))
+}
+
+func TestReverse(t *testing.T) {
+	desc := Reverse(Max[int])
+	assert.Negative(t, desc(ptr(2), ptr(1)))
+	assert.Positive(t, desc(ptr(1), ptr(2)))
+	assert.Zero(t, desc(ptr(1), ptr(1)))
+
+	assert.Equal(t, Max(ptr(4), ptr(7)), Reverse(Reverse(Max[int]))(ptr(4), ptr(7)))
+
+	type item struct{ weight int }
+	byWeight := func(a, b *item) int { return a.weight - b.weight }
+	assert.Negative(t, Reverse(byWeight)(&item{5}, &item{3}))
+}
@@ -0,0 +1,13 @@
+// Package cmputil provides reusable comparators for the pointer-based comparison
+// functions used throughout this repository, such as heap.NewHeap and
+// priorityqueue.NewPriorityQueue.
+//
+// A Comparator returns a positive value if a should come before b (for a heap,
+// closer to the root), a negative value if b should come first, and zero if they
+// are equivalent. Max and Min cover every cmp.Ordered type, and Reverse flips any
+// comparator:
+//
+//	maxHeap := heap.NewHeap(cmputil.Max[int])
+//	minHeap := heap.NewHeap(cmputil.Min[string])
+//	lowestFirst := priorityqueue.NewPriorityQueue(cmputil.Reverse(priorityqueue.PriorityCmp[string]))
+package cmputil
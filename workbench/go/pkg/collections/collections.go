@@ -0,0 +1,41 @@
+package collections
+
+import "iter"
+
+// Reduce folds seq into a single value: it starts from init and replaces the accumulator
+// with fn(accumulator, v) for every value v in order. An empty sequence returns init.
+//
+// Example:
+//
+//	total := Reduce(slices.Values([]int{1, 2, 3}), 0, func(acc, v int) int { return acc + v }) // 6
+func Reduce[T, A any](seq iter.Seq[T], init A, fn func(A, T) A) A {
+	acc := init
+	for v := range seq {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// Filter returns a sequence of the values of seq for which pred returns true, in order.
+// The returned sequence is lazy: seq is only ranged over when the result is.
+func Filter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a sequence of fn applied to each value of seq, in order.
+// The returned sequence is lazy: seq is only ranged over when the result is.
+func Map[T, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package collections
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	assert.Equal(t, 10, Reduce(slices.Values([]int{1, 2, 3, 4}), 0, sum))
+	assert.Equal(t, 7, Reduce(slices.Values([]int(nil)), 7, sum), "empty sequence returns init")
+
+	joined := Reduce(slices.Values([]int{1, 2, 3}), "", func(acc string, v int) string {
+		return acc + strconv.Itoa(v)
+	})
+	assert.Equal(t, "123", joined, "the accumulator type may differ from the element type")
+}
+
+func TestFilter(t *testing.T) {
+	evens := Filter(slices.Values([]int{1, 2, 3, 4, 5, 6}), func(v int) bool { return v%2 == 0 })
+	assert.Equal(t, []int{2, 4, 6}, slices.Collect(evens))
+
+	var got []int
+	for v := range evens {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{2, 4}, got, "stops when the consumer stops")
+}
+
+func TestMap(t *testing.T) {
+	calls := 0
+	labels := Map(slices.Values([]int{1, 2, 3}), func(v int) string {
+		calls++
+		return "#" + strconv.Itoa(v)
+	})
+	assert.Equal(t, 0, calls, "Map is lazy")
+	assert.Equal(t, []string{"#1", "#2", "#3"}, slices.Collect(labels))
+
+	for range labels {
+		break
+	}
+	assert.Equal(t, 4, calls, "stops when the consumer stops")
+}
+
+func TestPipeline(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3, 4})
+	evens := Filter(seq, func(v int) bool { return v%2 == 0 })
+	squares := Map(evens, func(v int) int { return v * v })
+	assert.Equal(t, 20, Reduce(squares, 0, func(acc, v int) int { return acc + v }))
+}
@@ -0,0 +1,15 @@
+// Package collections provides generic helpers over Go 1.23 iterators (iter.Seq), so
+// the same accumulation and transformation code works with every container in this
+// repository that exposes an All method, as well as with the slices and maps packages.
+//
+// Filter and Map are lazy: they return new sequences that do no work until ranged over.
+// Reduce consumes a sequence and folds it into a single value:
+//
+//	s := stack.NewStack[int](10)
+//	_, _ = s.PushAll([]int{1, 2, 3, 4})
+//	evens := collections.Filter(s.All(), func(v int) bool { return v%2 == 0 })
+//	squares := collections.Map(evens, func(v int) int { return v * v })
+//	sum := collections.Reduce(squares, 0, func(acc, v int) int { return acc + v }) // 20
+//
+// The same pipeline works with queue.Queue, linked_list.LinkedList or slices.Values.
+package collections
@@ -0,0 +1,35 @@
+/*
+Package graph provides a generic graph built from adjacency lists, with breadth-first
+and depth-first traversal.
+
+Each vertex keeps its neighbors in a linked_list.LinkedList in the order the edges were
+added, and vertices themselves are remembered in insertion order, so traversals are
+fully deterministic. BFS is driven by a queue.Queue and DFS by a stack.Stack, both in
+their growable variants.
+
+# Features
+
+- Generic implementation supporting any comparable vertex type
+- Directed or undirected edges, chosen when the graph is created
+- Deterministic BFS and DFS orders based on edge insertion order
+- Thread-safe for concurrent use by multiple goroutines
+
+# Performance Characteristics
+
+- AddVertex: O(1)
+- AddEdge: O(d) where d is the degree of the source vertex (duplicate edges are ignored)
+- Neighbors: O(d)
+- BFS/DFS: O(V + E) over the vertices reachable from the start
+- Space: O(V + E)
+
+# Basic Usage
+
+	g := graph.NewGraph[string](false) // undirected
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+
+	g.BFS("a") // [a b c d]
+	g.DFS("a") // [a b d c]
+*/
+package graph
@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"sync"
+
+	l "github.com/haru-256/ctci-6th-edition/pkg/linked_list"
+	"github.com/haru-256/ctci-6th-edition/pkg/queue"
+	"github.com/haru-256/ctci-6th-edition/pkg/stack"
+)
+
+// Graph is a generic graph stored as adjacency lists.
+// The zero value is not ready to use; use NewGraph to create a new graph.
+type Graph[T comparable] struct {
+	// adjacency maps each vertex to its neighbors in edge insertion order
+	adjacency map[T]*l.LinkedList[T]
+	// vertices records vertices in insertion order
+	vertices []T
+	// directed reports whether AddEdge adds only the from -> to direction
+	directed bool
+	mu       sync.RWMutex
+}
+
+// NewGraph creates and returns an empty graph.
+// If directed is true, AddEdge(from, to) adds only the edge from -> to;
+// otherwise it also adds to -> from.
+func NewGraph[T comparable](directed bool) *Graph[T] {
+	return &Graph[T]{
+		adjacency: make(map[T]*l.LinkedList[T]),
+		directed:  directed,
+	}
+}
+
+// Directed reports whether the graph is directed.
+func (g *Graph[T]) Directed() bool {
+	return g.directed
+}
+
+// AddVertex adds a vertex without any edges. Adding an existing vertex has no effect.
+// This method is thread-safe using exclusive locking.
+func (g *Graph[T]) AddVertex(v T) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addVertex(v)
+}
+
+// AddEdge adds an edge from one vertex to another, creating either vertex if needed.
+// In an undirected graph the reverse edge is added as well.
+// Adding an edge that already exists has no effect.
+// This method is thread-safe using exclusive locking.
+func (g *Graph[T]) AddEdge(from, to T) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addVertex(from)
+	g.addVertex(to)
+	g.addArc(from, to)
+	if !g.directed {
+		g.addArc(to, from)
+	}
+}
+
+// HasVertex reports whether v is a vertex of the graph.
+// This method is thread-safe using shared locking.
+func (g *Graph[T]) HasVertex(v T) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	_, ok := g.adjacency[v]
+	return ok
+}
+
+// Vertices returns all vertices in the order they were added.
+// This method is thread-safe using shared locking.
+func (g *Graph[T]) Vertices() []T {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	vertices := make([]T, len(g.vertices))
+	copy(vertices, g.vertices)
+	return vertices
+}
+
+// Neighbors returns the vertices adjacent to v in the order their edges were added,
+// or nil if v is not a vertex of the graph.
+// This method is thread-safe using shared locking.
+func (g *Graph[T]) Neighbors(v T) []T {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	neighbors, ok := g.adjacency[v]
+	if !ok {
+		return nil
+	}
+	return neighbors.ToSlice()
+}
+
+// BFS returns the vertices reachable from start in breadth-first order.
+// Neighbors are visited in the order their edges were added.
+// If start is not a vertex of the graph, BFS returns an empty slice.
+// This method is thread-safe using shared locking.
+func (g *Graph[T]) BFS(start T) []T {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	order := []T{}
+	if _, ok := g.adjacency[start]; !ok {
+		return order
+	}
+
+	visited := map[T]bool{start: true}
+	// A dynamic queue never overflows, so Enqueue errors can be ignored.
+	pending := queue.NewDynamicQueue[T]()
+	_ = pending.Enqueue(start)
+	for !pending.IsEmpty() {
+		v, _ := pending.Dequeue()
+		order = append(order, v)
+		g.adjacency[v].ForEach(func(next T) {
+			if !visited[next] {
+				visited[next] = true
+				_ = pending.Enqueue(next)
+			}
+		})
+	}
+	return order
+}
+
+// DFS returns the vertices reachable from start in depth-first preorder,
+// matching a recursive traversal that follows neighbors in the order their edges were added.
+// If start is not a vertex of the graph, DFS returns an empty slice.
+// This method is thread-safe using shared locking.
+func (g *Graph[T]) DFS(start T) []T {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	order := []T{}
+	if _, ok := g.adjacency[start]; !ok {
+		return order
+	}
+
+	visited := make(map[T]bool)
+	// A dynamic stack never overflows, so Push errors can be ignored.
+	pending := stack.NewDynamicStack[T]()
+	_ = pending.Push(start)
+	for !pending.IsEmpty() {
+		v, _ := pending.Pop()
+		if visited[v] {
+			continue
+		}
+		visited[v] = true
+		order = append(order, v)
+
+		// Push neighbors in reverse so the first neighbor is explored first
+		neighbors := g.adjacency[v].ToSlice()
+		for i := len(neighbors) - 1; i >= 0; i-- {
+			if !visited[neighbors[i]] {
+				_ = pending.Push(neighbors[i])
+			}
+		}
+	}
+	return order
+}
+
+// addVertex adds v if it is not already present.
+// This method assumes the caller already holds the write lock.
+func (g *Graph[T]) addVertex(v T) {
+	if _, ok := g.adjacency[v]; ok {
+		return
+	}
+	g.adjacency[v] = l.NewLinkedList[T]()
+	g.vertices = append(g.vertices, v)
+}
+
+// addArc adds the one-way edge from -> to unless it already exists.
+// This method assumes the caller already holds the write lock and that from is a vertex.
+func (g *Graph[T]) addArc(from, to T) {
+	neighbors := g.adjacency[from]
+	if neighbors.Search(to) == nil {
+		neighbors.Append(to)
+	}
+}
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGraph(t *testing.T) {
+	g := NewGraph[int](true)
+	assert.True(t, g.Directed())
+	assert.Empty(t, g.Vertices())
+	assert.False(t, g.HasVertex(1))
+	assert.Nil(t, g.Neighbors(1))
+	assert.Equal(t, []int{}, g.BFS(1))
+	assert.Equal(t, []int{}, g.DFS(1))
+
+	assert.False(t, NewGraph[int](false).Directed())
+}
+
+func TestGraph_AddEdge(t *testing.T) {
+	t.Run("directed", func(t *testing.T) {
+		g := NewGraph[string](true)
+		g.AddEdge("a", "b")
+		g.AddEdge("a", "c")
+		g.AddEdge("a", "b") // duplicate is ignored
+
+		assert.Equal(t, []string{"a", "b", "c"}, g.Vertices())
+		assert.Equal(t, []string{"b", "c"}, g.Neighbors("a"))
+		assert.Empty(t, g.Neighbors("b"))
+	})
+
+	t.Run("undirected", func(t *testing.T) {
+		g := NewGraph[string](false)
+		g.AddEdge("a", "b")
+		g.AddEdge("b", "a") // same edge in an undirected graph
+		g.AddEdge("b", "c")
+
+		assert.Equal(t, []string{"b"}, g.Neighbors("a"))
+		assert.Equal(t, []string{"a", "c"}, g.Neighbors("b"))
+		assert.Equal(t, []string{"b"}, g.Neighbors("c"))
+	})
+
+	t.Run("isolated vertex", func(t *testing.T) {
+		g := NewGraph[int](false)
+		g.AddVertex(7)
+		g.AddVertex(7)
+		assert.True(t, g.HasVertex(7))
+		assert.Equal(t, []int{7}, g.Vertices())
+		assert.Equal(t, []int{7}, g.BFS(7))
+		assert.Equal(t, []int{7}, g.DFS(7))
+	})
+}
+
+func TestGraph_Traversal(t *testing.T) {
+	//     1
+	//    / \
+	//   2   3
+	//  / \   \
+	// 4   5 - 6
+	edges := [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}, {3, 6}, {5, 6}}
+
+	t.Run("undirected", func(t *testing.T) {
+		g := NewGraph[int](false)
+		for _, e := range edges {
+			g.AddEdge(e[0], e[1])
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, g.BFS(1))
+		assert.Equal(t, []int{1, 2, 4, 5, 6, 3}, g.DFS(1))
+		assert.Equal(t, []int{6, 3, 5, 1, 2, 4}, g.BFS(6))
+		assert.Equal(t, []int{6, 3, 1, 2, 4, 5}, g.DFS(6))
+	})
+
+	t.Run("directed", func(t *testing.T) {
+		g := NewGraph[int](true)
+		for _, e := range edges {
+			g.AddEdge(e[0], e[1])
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, g.BFS(1))
+		assert.Equal(t, []int{1, 2, 4, 5, 6, 3}, g.DFS(1))
+		assert.Equal(t, []int{2, 4, 5, 6}, g.BFS(2))
+		assert.Equal(t, []int{6}, g.DFS(6), "no outgoing edges from 6")
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		g := NewGraph[string](true)
+		g.AddEdge("a", "b")
+		g.AddEdge("b", "c")
+		g.AddEdge("c", "a")
+		assert.Equal(t, []string{"a", "b", "c"}, g.BFS("a"))
+		assert.Equal(t, []string{"b", "c", "a"}, g.DFS("b"))
+	})
+}
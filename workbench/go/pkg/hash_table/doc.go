@@ -17,7 +17,7 @@ FNV-1a hashing for consistent key distribution.
 # Performance Characteristics
 
 - Insert: O(1) average, O(n) worst case (with many collisions)
-- Search: O(1) average, O(n) worst case (with many collisions)
+- Get/Search: O(1) average, O(n) worst case (with many collisions)
 - Delete: O(1) average, O(n) worst case (with many collisions)
 - Space: O(n + m) where n is number of elements and m is table size
 
@@ -42,13 +42,13 @@ Load factor affects performance. For optimal results, keep load factor below 0.7
 		log.Fatal(err)
 	}
 
-	// Search for values
-	node, err := table.Search("banana")
+	// Look up values
+	value, found, err := table.Get("banana")
 	if err != nil {
 		log.Fatal(err)
 	}
-	if node != nil {
-		fmt.Printf("Found: %s\n", node.Value)
+	if found {
+		fmt.Printf("Found: %s\n", value)
 	}
 
 	// Check table size
@@ -60,6 +60,26 @@ Load factor affects performance. For optimal results, keep load factor below 0.7
 		log.Fatal(err)
 	}
 
+To build a table from existing data in one call, use NewHashChainTableFromSlice.
+Its last argument chooses whether repeated values are skipped or reported as ErrorAlreadyExists:
+
+	table, err := hashtable.NewHashChainTableFromSlice([]string{"a", "b", "a"}, 10, true)
+	// table.Size() == 2
+
+To enumerate the table without holding its lock while processing, take a Snapshot.
+It copies every value under a brief read lock and reflects the table at that moment:
+
+	for _, value := range table.Snapshot() {
+		process(value) // writers are not blocked here
+	}
+
+All wraps the same snapshot in an iter.Seq for range-over-func loops and the slices helpers:
+
+	for value := range table.All() {
+		process(value)
+	}
+	values := slices.Collect(table.All())
+
 # Advanced Usage
 
 	// Create a hash table for custom types
@@ -90,12 +110,12 @@ Load factor affects performance. For optimal results, keep load factor below 0.7
 
 	// Search for specific user
 	target := User{ID: 2, Name: "Bob"}
-	node, err := userTable.Search(target)
+	user, found, err := userTable.Get(target)
 	if err != nil {
 		log.Fatal(err)
 	}
-	if node != nil {
-		fmt.Printf("Found user: %+v\n", node.Value)
+	if found {
+		fmt.Printf("Found user: %+v\n", user)
 	}
 
 # Collision Resolution
@@ -116,12 +136,12 @@ The hash table uses separate chaining with doubly linked lists:
 
 	// All values are stored and searchable despite collisions
 	for _, value := range values {
-		node, err := smallTable.Search(value)
+		stored, found, err := smallTable.Get(value)
 		if err != nil {
 			log.Fatal(err)
 		}
-		if node != nil {
-			fmt.Printf("Found: %d\n", node.Value)
+		if found {
+			fmt.Printf("Found: %d\n", stored)
 		}
 	}
 
@@ -141,13 +161,83 @@ For optimal performance, monitor and manage load factor:
 	}
 
 	// Calculate load factor
-	loadFactor := float64(table.Size()) / float64(table.MaxSize)
+	loadFactor := table.LoadFactor()
 	fmt.Printf("Load factor: %.2f\n", loadFactor)
 
 	if loadFactor > 0.75 {
 		fmt.Println("Consider increasing table size for better performance")
 	}
 
+Before a bulk load of known size, call Reserve to rehash once into a table sized for
+the expected number of elements (keeping the load factor at or below 0.75 for chaining,
+and avoiding the repeated doublings of the open addressing table):
+
+	table := hashtable.NewHashChainTable[string](16)
+	table.Reserve(10000) // rehashes once to about 13,334 buckets
+
+Instead of picking a bucket count, NewHashChainTableWithOptions lets you state how many
+elements you expect. WithInitialCapacity sizes the table for them within the load factor
+threshold (0.75 by default, changed with WithLoadFactorThreshold), and WithHasher plugs in a
+custom hash function. NewHashChainTable keeps working as before:
+
+	table := hashtable.NewHashChainTableWithOptions(
+		hashtable.WithInitialCapacity[string](10000),
+		hashtable.WithLoadFactorThreshold[string](0.5),
+	)
+	fmt.Printf("Load factor: %.2f\n", table.LoadFactor())
+
+# Open Addressing
+
+HashOpenAddressTable is an alternative implementation with the same Insert/Search/Delete/Size
+operations that stores values directly in a slice and resolves collisions with linear probing.
+Deleted entries leave tombstones so that probe sequences stay intact. When live values and
+tombstones together exceed a load factor of 0.7, the table rehashes itself: it doubles if
+the live values need the space, and otherwise keeps its size and just clears the tombstones:
+
+	table := hashtable.NewHashOpenAddressTable[int](16)
+	for i := 0; i < 100; i++ {
+		_ = table.Insert(i) // grows automatically
+	}
+	found, err := table.Search(42)
+
+For small comparable values this avoids the pointer overhead and cache misses of chaining.
+
+HashChainTable also keeps Search, which returns the bucket's linked-list node, for backward
+compatibility. New code should use Get, which returns the stored value and a found flag
+without exposing how chains are represented.
+
+Code that does need the chain, such as a cache promoting hot entries, can call SearchNode.
+It returns the node and its bucket index; reorder the chain only through the bucket's
+LinkedList methods so that its Head and Tail stay consistent, e.g. move-to-front:
+
+	node, index, err := table.SearchNode("hot")
+	if err == nil && node != nil {
+		bucket := table.Table[index]
+		_ = bucket.DeleteNode(node)
+		bucket.Prepend(node.Value)
+	}
+
+For skewed access patterns, NewSelfAdjustingHashTable does this automatically: every hit
+in Get or Search moves the value to the front of its chain, so hot values are found after
+fewer comparisons. Because a hit reorders the chain, lookups take the write lock and no
+longer run in parallel; BenchmarkSelfAdjustingHashTable_ZipfGet compares both modes.
+
+	table := hashtable.NewSelfAdjustingHashTable[string](64)
+
+# Multiset
+
+HashChainTable is a set: inserting a value twice returns ErrorAlreadyExists. To count
+occurrences, use HashMultiset, where Insert increments a per-value count and Delete
+decrements it, removing the value when the count reaches zero:
+
+	words := hashtable.NewHashMultiset[string]()
+	for _, w := range []string{"a", "b", "a"} {
+		_ = words.Insert(w)
+	}
+	fmt.Println(words.Count("a"))  // 2
+	fmt.Println(words.Size())      // 3 (total, including duplicates)
+	fmt.Println(words.Distinct())  // 2 (unique values)
+
 # Concurrency
 
 The hash table is thread-safe for all operations:
@@ -173,13 +263,13 @@ The hash table is thread-safe for all operations:
 		wg.Add(1)
 		go func(value int) {
 			defer wg.Done()
-			node, err := table.Search(value)
+			stored, found, err := table.Get(value)
 			if err != nil {
-				log.Printf("Search error: %v", err)
+				log.Printf("Get error: %v", err)
 				return
 			}
-			if node != nil {
-				fmt.Printf("Found: %d\n", node.Value)
+			if found {
+				fmt.Printf("Found: %d\n", stored)
 			}
 		}(i)
 	}
@@ -200,6 +290,15 @@ The hash table uses FNV-1a hashing for key generation:
 		fmt.Println("Boolean type not supported for hashing")
 	}
 
+Types not supported by the default hashing can be stored by supplying a custom hash function:
+
+	type Point struct{ X, Y int }
+
+	points := hashtable.NewHashChainTableWithHasher(50, func(p Point) (uint64, error) {
+		return uint64(p.X)*31 + uint64(p.Y), nil
+	})
+	err := points.Insert(Point{X: 1, Y: 2})
+
 The FNV-1a algorithm provides:
 - Fast computation with good distribution
 - Deterministic results for consistent behavior
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"hash"
 	"hash/fnv"
+	"iter"
 	"math"
 	"sync"
 
@@ -21,6 +22,11 @@ var (
 	ErrorNodeNotFound = errors.New("node not found in the hash table")
 )
 
+// defaultLoadFactorThreshold is the load factor (elements over buckets) that Reserve
+// keeps HashChainTable below unless configured otherwise. Above it chains grow long
+// enough to slow down lookups.
+const defaultLoadFactorThreshold = 0.75
+
 // hasherPool is a pool of FNV-1a hashers to avoid allocations in getHash.
 // This provides thread-safe access to reusable hash.Hash64 instances,
 // improving performance by reducing garbage collection pressure.
@@ -37,25 +43,87 @@ type HashChainTable[T comparable] struct {
 	// Table is an array of linked lists, where each bucket can contain multiple values
 	Table []*l.LinkedList[T]
 	// MaxSize is the maximum number of buckets in the hash table
-	MaxSize int
+	MaxSize int64
 	// size tracks the total number of elements currently stored in the hash table
 	size int
+	// hashFn is an optional user-supplied hash function; nil means the default FNV-1a hashing
+	hashFn func(T) (uint64, error)
+	// loadFactorThreshold is the load factor that Reserve sizes the table for
+	loadFactorThreshold float64
+	// selfAdjusting makes Get and Search move a found value to the front of its chain
+	selfAdjusting bool
 	// mu provides thread-safe access to the hash table
 	mu sync.RWMutex
 }
 
+// Stats describes how elements are distributed across the buckets of a hash table.
+type Stats struct {
+	// NonEmptyBuckets is the number of buckets holding at least one element
+	NonEmptyBuckets int
+	// LongestChain is the length of the longest chain in any bucket
+	LongestChain int
+	// AverageChainLength is the mean chain length over non-empty buckets
+	AverageChainLength float64
+	// LoadFactor is the number of elements divided by the number of buckets
+	LoadFactor float64
+}
+
 // NewHashChainTable creates and returns a new hash table with the specified maximum size.
 // The maxSize parameter determines the number of buckets in the hash table.
 // All buckets are initially empty (nil).
-func NewHashChainTable[T comparable](maxSize int) *HashChainTable[T] {
+func NewHashChainTable[T comparable](maxSize int64) *HashChainTable[T] {
 	if maxSize <= 0 {
 		panic("hashtable: maxSize must be positive")
 	}
 	return &HashChainTable[T]{
-		Table:   make([]*l.LinkedList[T], maxSize),
-		MaxSize: maxSize,
-		size:    0,
+		Table:               make([]*l.LinkedList[T], maxSize),
+		MaxSize:             maxSize,
+		size:                0,
+		loadFactorThreshold: defaultLoadFactorThreshold,
+	}
+}
+
+// NewHashChainTableWithHasher creates a new hash table that uses hashFn to compute hashes.
+// This allows hashing value types that the default FNV-1a path rejects (e.g. bool or structs)
+// or plugging in a faster hash for specific key types.
+// If hashFn is nil, the default FNV-1a hashing is used.
+func NewHashChainTableWithHasher[T comparable](maxSize int64, hashFn func(T) (uint64, error)) *HashChainTable[T] {
+	table := NewHashChainTable[T](maxSize)
+	table.hashFn = hashFn
+	return table
+}
+
+// NewSelfAdjustingHashTable creates a hash table with maxSize buckets whose Get and Search
+// move a found value to the front of its bucket's chain (move-to-front), so that values
+// looked up often are found after fewer comparisons. This pays off for skewed access
+// patterns on long chains.
+//
+// The trade-off is locking: a hit reorders the chain, so Get and Search take the write lock
+// instead of a read lock, and concurrent lookups no longer run in parallel. Prefer a plain
+// table (or a larger one, with shorter chains) for read-heavy workloads with many goroutines.
+func NewSelfAdjustingHashTable[T comparable](maxSize int64) *HashChainTable[T] {
+	table := NewHashChainTable[T](maxSize)
+	table.selfAdjusting = true
+	return table
+}
+
+// NewHashChainTableFromSlice creates a hash table with maxSize buckets and inserts every value.
+// If skipDuplicates is true, repeated values are inserted once and the rest are ignored;
+// otherwise the first repeated value makes it return ErrorAlreadyExists.
+// If a value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// No table is returned when an error occurs.
+func NewHashChainTableFromSlice[T comparable](values []T, maxSize int64, skipDuplicates bool) (*HashChainTable[T], error) {
+	table := NewHashChainTable[T](maxSize)
+	for _, value := range values {
+		err := table.Insert(value)
+		if errors.Is(err, ErrorAlreadyExists) && skipDuplicates {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
+	return table, nil
 }
 
 // Size returns the total number of elements currently stored in the hash table.
@@ -66,6 +134,122 @@ func (table *HashChainTable[T]) Size() int {
 	return table.size
 }
 
+// LoadFactor returns the number of elements divided by the number of buckets.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (table *HashChainTable[T]) LoadFactor() float64 {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+	return float64(table.size) / float64(table.MaxSize)
+}
+
+// Stats walks all buckets and returns chain length statistics for the hash table.
+// This is useful for diagnosing hot buckets and deciding when the table should be resized.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (table *HashChainTable[T]) Stats() Stats {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+
+	var stats Stats
+	total := 0
+	for _, bucket := range table.Table {
+		if bucket == nil {
+			continue
+		}
+		length := bucket.Len()
+		if length == 0 {
+			continue
+		}
+		stats.NonEmptyBuckets++
+		total += length
+		if length > stats.LongestChain {
+			stats.LongestChain = length
+		}
+	}
+	if stats.NonEmptyBuckets > 0 {
+		stats.AverageChainLength = float64(total) / float64(stats.NonEmptyBuckets)
+	}
+	stats.LoadFactor = float64(table.size) / float64(table.MaxSize)
+	return stats
+}
+
+// Snapshot returns a copy of every value stored in the hash table, including all values
+// that share a bucket's chain. The copy is taken under a read lock that is released before
+// returning, so callers can iterate over the result for as long as they like without
+// blocking writers. The result is a point-in-time view: later inserts and deletes are not
+// reflected in it. Values are ordered by bucket and then by position in the chain,
+// which is not meaningful to callers.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (table *HashChainTable[T]) Snapshot() []T {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+
+	values := make([]T, 0, table.size)
+	for _, bucket := range table.Table {
+		if bucket == nil {
+			continue
+		}
+		bucket.ForEach(func(value T) {
+			values = append(values, value)
+		})
+	}
+	return values
+}
+
+// All returns an iterator over every value in the hash table, for use with range-over-func
+// and the slices and maps helpers, e.g. slices.Collect(table.All()).
+// It iterates over a Snapshot taken when iteration starts, so the loop body may insert or
+// delete without blocking or deadlocking, and those changes are not seen by the loop.
+// Values come in the same unspecified bucket order as Snapshot.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (table *HashChainTable[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range table.Snapshot() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Reserve prepares the table to hold expectedElements values without exceeding its load
+// factor threshold (0.75 unless set with WithLoadFactorThreshold). If the current number
+// of buckets is too small, the table is rehashed into a larger one once, up front,
+// instead of degrading as a bulk load proceeds.
+// If the table is already large enough, Reserve does nothing; it never shrinks the table.
+// This method is thread-safe and uses a write lock for concurrent access.
+func (table *HashChainTable[T]) Reserve(expectedElements int) {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	if float64(expectedElements) <= table.loadFactorThreshold*float64(table.MaxSize) {
+		return
+	}
+	table.rehash(bucketsFor(expectedElements, table.loadFactorThreshold))
+}
+
+// rehash reallocates the buckets with newSize entries and redistributes all values.
+// This method assumes the caller already holds the write lock.
+func (table *HashChainTable[T]) rehash(newSize int64) {
+	old := table.Table
+	table.Table = make([]*l.LinkedList[T], newSize)
+	table.MaxSize = newSize
+
+	for _, bucket := range old {
+		if bucket == nil {
+			continue
+		}
+		bucket.ForEach(func(value T) {
+			// Values already in the table were hashed successfully on insert.
+			hash, _ := table.getHash(value)
+			index := hash % uint64(newSize)
+			if table.Table[index] == nil {
+				table.Table[index] = l.NewLinkedList[T]()
+			}
+			table.Table[index].Prepend(value)
+		})
+	}
+}
+
 // Insert adds a new value to the hash table.
 // If the value already exists, it returns ErrorAlreadyExists.
 // If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
@@ -92,14 +276,43 @@ func (table *HashChainTable[T]) Insert(value T) error {
 	return nil
 }
 
+// Get looks for a value in the hash table and returns the stored value.
+// The boolean reports whether the value was found; if it was not, the zero value is returned.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// Unlike Search, it does not expose the bucket's linked-list node, so prefer Get in new code.
+// This method is thread-safe and uses a read lock for concurrent access
+// (a write lock for tables created with NewSelfAdjustingHashTable).
+func (table *HashChainTable[T]) Get(value T) (T, bool, error) {
+	node, err := table.lookup(value)
+	if err != nil || node == nil {
+		var zero T
+		return zero, false, err
+	}
+	return node.Value, true, nil
+}
+
 // Search looks for a value in the hash table and returns the corresponding node.
 // If the value is found, it returns the node containing the value.
 // If the value is not found, it returns nil for the node.
 // If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
-// This method is thread-safe and uses a read lock for concurrent access.
+// It is kept for backward compatibility; Get returns the value without exposing the node.
+// This method is thread-safe and uses a read lock for concurrent access
+// (a write lock for tables created with NewSelfAdjustingHashTable).
 func (table *HashChainTable[T]) Search(value T) (*l.Node[T], error) {
-	table.mu.RLock()
-	defer table.mu.RUnlock()
+	return table.lookup(value)
+}
+
+// lookup finds the node holding value, or returns nil if there is none.
+// For self-adjusting tables it holds the write lock and moves a found value to the front
+// of its chain; otherwise it holds a read lock.
+func (table *HashChainTable[T]) lookup(value T) (*l.Node[T], error) {
+	if table.selfAdjusting {
+		table.mu.Lock()
+		defer table.mu.Unlock()
+	} else {
+		table.mu.RLock()
+		defer table.mu.RUnlock()
+	}
 
 	hash, err := table.getHash(value)
 	if err != nil {
@@ -107,11 +320,52 @@ func (table *HashChainTable[T]) Search(value T) (*l.Node[T], error) {
 	}
 
 	index := hash % uint64(table.MaxSize)
-	if table.Table[index] == nil {
+	bucket := table.Table[index]
+	if bucket == nil {
 		return nil, nil
 	}
+	node := bucket.Search(value)
+	if node == nil || !table.selfAdjusting || node == bucket.Head() {
+		return node, nil
+	}
+
+	// Move to front: unlink the node and prepend its value, which becomes the new head
+	if err := bucket.DeleteNode(node); err != nil {
+		return nil, err
+	}
+	bucket.Prepend(node.Value)
+	return bucket.Head(), nil
+}
+
+// SearchNode looks for a value and returns its linked-list node together with the index
+// of the bucket that holds it, so that callers can reorder the chain, e.g. move a hot
+// entry to the front with table.Table[index].DeleteNode followed by Prepend.
+// If the value is not found, the node is nil and the index is that of the bucket the value
+// hashes to. If the value type is not supported for hashing, it returns ErrorUnsupportedValueType
+// and an index of -1.
+//
+// The node stays owned by the bucket's linked list. Change its position only through
+// LinkedList methods such as DeleteNode, Prepend and InsertBefore: rewiring Prev or Next directly
+// leaves the list's Head, Tail and length inconsistent. Changing node.Value to a different
+// value breaks lookups, since the value would no longer match its bucket.
+// The table's lock is released before SearchNode returns; the linked list methods have
+// their own lock, but callers that combine them with concurrent Insert or Delete on the
+// same table must coordinate externally.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (table *HashChainTable[T]) SearchNode(value T) (*l.Node[T], int, error) {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
 
-	return table.Table[index].Search(value), nil
+	hash, err := table.getHash(value)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	index := hash % uint64(table.MaxSize)
+	if table.Table[index] == nil {
+		return nil, int(index), nil
+	}
+	return table.Table[index].Search(value), int(index), nil
 }
 
 // Delete removes a value from the hash table.
@@ -144,12 +398,22 @@ func (table *HashChainTable[T]) Delete(value T) error {
 	return nil
 }
 
-// getHash computes and returns the FNV-1a hash of a given value.
+// getHash computes and returns the hash of a given value.
+// If a custom hash function was provided, it is used instead of FNV-1a.
+// Otherwise the value is hashed with fnvHash.
+func (table *HashChainTable[T]) getHash(value T) (uint64, error) {
+	if table.hashFn != nil {
+		return table.hashFn(value)
+	}
+	return fnvHash(value)
+}
+
+// fnvHash computes and returns the FNV-1a hash of a given value.
 // It uses a sync.Pool to reuse hasher objects, making it safe for concurrent use
 // and avoiding allocations on each call.
 // It supports int, float64, and string types. For other types,
 // it returns ErrorUnsupportedValueType.
-func (table *HashChainTable[T]) getHash(value T) (uint64, error) {
+func fnvHash[T comparable](value T) (uint64, error) {
 	// Get a hasher from the pool and defer returning it
 	hasher := hasherPool.Get().(hash.Hash64)
 	defer hasherPool.Put(hasher)
@@ -1,6 +1,8 @@
 package hashtable
 
 import (
+	"math/rand"
+	"slices"
 	"sync"
 	"testing"
 
@@ -12,7 +14,7 @@ import (
 func TestNewHashChainTable(t *testing.T) {
 	tests := []struct {
 		name    string
-		maxSize int
+		maxSize int64
 	}{
 		{"small table", 5},
 		{"medium table", 100},
@@ -26,10 +28,10 @@ func TestNewHashChainTable(t *testing.T) {
 			assert.NotNil(t, table)
 			assert.Equal(t, tt.maxSize, table.MaxSize)
 			assert.Equal(t, 0, table.Size())
-			assert.Equal(t, tt.maxSize, len(table.Table))
+			assert.Len(t, table.Table, int(tt.maxSize))
 
 			// Verify all buckets are initially nil
-			for i := int(0); i < tt.maxSize; i++ {
+			for i := int64(0); i < tt.maxSize; i++ {
 				assert.Nil(t, table.Table[i])
 			}
 		})
@@ -39,7 +41,7 @@ func TestNewHashChainTable(t *testing.T) {
 func TestHashChainTable_Size(t *testing.T) {
 	tests := []struct {
 		name         string
-		maxSize      int
+		maxSize      int64
 		insertValues []string
 		expectedSize int
 	}{
@@ -86,7 +88,7 @@ func TestHashChainTable_Size(t *testing.T) {
 func TestHashChainTable_Insert_Strings(t *testing.T) {
 	tests := []struct {
 		name           string
-		maxSize        int
+		maxSize        int64
 		insertValues   []string
 		expectedErrors []error
 		finalSize      int
@@ -126,7 +128,7 @@ func TestHashChainTable_Insert_Strings(t *testing.T) {
 func TestHashChainTable_Insert_Integers(t *testing.T) {
 	tests := []struct {
 		name           string
-		maxSize        int
+		maxSize        int64
 		insertValues   []int
 		expectedErrors []error
 		finalSize      int
@@ -159,7 +161,7 @@ func TestHashChainTable_Insert_Integers(t *testing.T) {
 func TestHashChainTable_Insert_Floats(t *testing.T) {
 	tests := []struct {
 		name           string
-		maxSize        int
+		maxSize        int64
 		insertValues   []float64
 		expectedErrors []error
 		finalSize      int
@@ -199,7 +201,7 @@ func TestHashChainTable_Insert_UnsupportedType(t *testing.T) {
 func TestHashChainTable_Search(t *testing.T) {
 	tests := []struct {
 		name          string
-		maxSize       int
+		maxSize       int64
 		insertValues  []string
 		searchValue   string
 		expectFound   bool
@@ -278,7 +280,7 @@ func TestHashChainTable_Search_UnsupportedType(t *testing.T) {
 func TestHashChainTable_Delete(t *testing.T) {
 	tests := []struct {
 		name          string
-		maxSize       int
+		maxSize       int64
 		insertValues  []string
 		deleteValue   string
 		expectError   bool
@@ -488,3 +490,422 @@ func TestHashChainTable_CompleteWorkflow(t *testing.T) {
 		assert.Equal(t, value, node.Value)
 	}
 }
+
+func TestNewHashChainTableWithHasher(t *testing.T) {
+	t.Run("bool values with custom hasher", func(t *testing.T) {
+		table := NewHashChainTableWithHasher(5, func(v bool) (uint64, error) {
+			if v {
+				return 1, nil
+			}
+			return 0, nil
+		})
+
+		require.NoError(t, table.Insert(true))
+		require.NoError(t, table.Insert(false))
+		assert.ErrorIs(t, table.Insert(true), ErrorAlreadyExists)
+		assert.Equal(t, 2, table.Size())
+
+		node, err := table.Search(true)
+		require.NoError(t, err)
+		require.NotNil(t, node)
+		assert.True(t, node.Value)
+
+		require.NoError(t, table.Delete(true))
+		assert.Equal(t, 1, table.Size())
+	})
+
+	t.Run("struct values with custom hasher", func(t *testing.T) {
+		type point struct{ X, Y int }
+		table := NewHashChainTableWithHasher(10, func(p point) (uint64, error) {
+			return uint64(p.X)*31 + uint64(p.Y), nil
+		})
+
+		points := []point{{1, 2}, {3, 4}, {5, 6}}
+		for _, p := range points {
+			require.NoError(t, table.Insert(p))
+		}
+		for _, p := range points {
+			node, err := table.Search(p)
+			require.NoError(t, err)
+			require.NotNil(t, node)
+			assert.Equal(t, p, node.Value)
+		}
+	})
+
+	t.Run("hasher error is propagated", func(t *testing.T) {
+		table := NewHashChainTableWithHasher(5, func(int) (uint64, error) {
+			return 0, ErrorUnsupportedValueType
+		})
+		assert.ErrorIs(t, table.Insert(1), ErrorUnsupportedValueType)
+		assert.Equal(t, 0, table.Size())
+	})
+
+	t.Run("nil hasher falls back to FNV-1a", func(t *testing.T) {
+		table := NewHashChainTableWithHasher[string](5, nil)
+		require.NoError(t, table.Insert("apple"))
+		_, err := NewHashChainTableWithHasher[bool](5, nil).getHash(true)
+		assert.ErrorIs(t, err, ErrorUnsupportedValueType)
+	})
+}
+
+func TestHashChainTable_Stats(t *testing.T) {
+	t.Run("empty table", func(t *testing.T) {
+		table := NewHashChainTable[int](10)
+		assert.Equal(t, Stats{}, table.Stats())
+	})
+
+	t.Run("single bucket", func(t *testing.T) {
+		table := NewHashChainTable[string](1)
+		for _, v := range []string{"a", "b", "c"} {
+			require.NoError(t, table.Insert(v))
+		}
+
+		stats := table.Stats()
+		assert.Equal(t, 1, stats.NonEmptyBuckets)
+		assert.Equal(t, 3, stats.LongestChain)
+		assert.InDelta(t, 3.0, stats.AverageChainLength, 1e-9)
+		assert.InDelta(t, 3.0, stats.LoadFactor, 1e-9)
+	})
+
+	t.Run("custom hasher distribution", func(t *testing.T) {
+		table := NewHashChainTableWithHasher(4, func(v int) (uint64, error) {
+			return uint64(v), nil
+		})
+		// buckets: 0 -> {0, 4, 8}, 1 -> {1}
+		for _, v := range []int{0, 4, 8, 1} {
+			require.NoError(t, table.Insert(v))
+		}
+
+		stats := table.Stats()
+		assert.Equal(t, 2, stats.NonEmptyBuckets)
+		assert.Equal(t, 3, stats.LongestChain)
+		assert.InDelta(t, 2.0, stats.AverageChainLength, 1e-9)
+		assert.InDelta(t, 1.0, stats.LoadFactor, 1e-9)
+	})
+}
+
+func TestNewHashChainTable_Int64Boundaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxSize int64
+	}{
+		{"single bucket", 1},
+		{"large table", 1 << 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := NewHashChainTable[int](tt.maxSize)
+
+			assert.Equal(t, tt.maxSize, table.MaxSize)
+			assert.Len(t, table.Table, int(tt.maxSize))
+
+			for i := 0; i < 10; i++ {
+				require.NoError(t, table.Insert(i))
+			}
+			for i := 0; i < 10; i++ {
+				node, err := table.Search(i)
+				require.NoError(t, err)
+				require.NotNil(t, node)
+				assert.Equal(t, i, node.Value)
+			}
+			require.NoError(t, table.Delete(5))
+			assert.Equal(t, 9, table.Size())
+		})
+	}
+
+	assert.Panics(t, func() { NewHashChainTable[int](0) })
+	assert.Panics(t, func() { NewHashChainTable[int](-1) })
+}
+
+func TestNewHashChainTableFromSlice(t *testing.T) {
+	t.Run("unique values", func(t *testing.T) {
+		values := []string{"apple", "banana", "cherry"}
+		table, err := NewHashChainTableFromSlice(values, 5, false)
+		require.NoError(t, err)
+		assert.Equal(t, 3, table.Size())
+		for _, v := range values {
+			node, err := table.Search(v)
+			require.NoError(t, err)
+			assert.NotNil(t, node, "value %q should be present", v)
+		}
+	})
+
+	t.Run("skip duplicates", func(t *testing.T) {
+		table, err := NewHashChainTableFromSlice([]int{1, 2, 1, 3, 2}, 5, true)
+		require.NoError(t, err)
+		assert.Equal(t, 3, table.Size())
+	})
+
+	t.Run("error on duplicates", func(t *testing.T) {
+		table, err := NewHashChainTableFromSlice([]int{1, 2, 1}, 5, false)
+		assert.ErrorIs(t, err, ErrorAlreadyExists)
+		assert.Nil(t, table)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		table, err := NewHashChainTableFromSlice[int](nil, 5, false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, table.Size())
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := NewHashChainTableFromSlice([]bool{true}, 5, true)
+		assert.ErrorIs(t, err, ErrorUnsupportedValueType)
+	})
+}
+
+func TestHashChainTable_Snapshot(t *testing.T) {
+	t.Run("empty table", func(t *testing.T) {
+		table := NewHashChainTable[int](4)
+		assert.Empty(t, table.Snapshot())
+	})
+
+	t.Run("includes collided values", func(t *testing.T) {
+		// A single bucket forces every value into the same chain
+		table := NewHashChainTable[int](1)
+		values := []int{1, 2, 3, 4, 5}
+		for _, v := range values {
+			require.NoError(t, table.Insert(v))
+		}
+		assert.ElementsMatch(t, values, table.Snapshot())
+	})
+
+	t.Run("point-in-time view", func(t *testing.T) {
+		table := NewHashChainTable[string](8)
+		require.NoError(t, table.Insert("apple"))
+		require.NoError(t, table.Insert("banana"))
+
+		snapshot := table.Snapshot()
+		require.NoError(t, table.Insert("cherry"))
+		require.NoError(t, table.Delete("apple"))
+
+		assert.ElementsMatch(t, []string{"apple", "banana"}, snapshot)
+		assert.ElementsMatch(t, []string{"banana", "cherry"}, table.Snapshot())
+	})
+
+	t.Run("concurrent writers", func(t *testing.T) {
+		table := NewHashChainTable[int](16)
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func(base int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					_ = table.Insert(base*100 + j)
+				}
+			}(i)
+		}
+		for i := 0; i < 10; i++ {
+			assert.LessOrEqual(t, len(table.Snapshot()), 400)
+		}
+		wg.Wait()
+		assert.Len(t, table.Snapshot(), 400)
+	})
+}
+
+func TestHashChainTable_All(t *testing.T) {
+	table := NewHashChainTable[int](2)
+	assert.Empty(t, slices.Collect(table.All()))
+
+	values := []int{1, 2, 3, 4, 5}
+	for _, v := range values {
+		require.NoError(t, table.Insert(v))
+	}
+	assert.ElementsMatch(t, values, slices.Collect(table.All()))
+
+	// The loop body may modify the table; iteration sees the values at the start.
+	var seen []int
+	for v := range table.All() {
+		seen = append(seen, v)
+		require.NoError(t, table.Delete(v))
+		require.NoError(t, table.Insert(v+100))
+	}
+	assert.ElementsMatch(t, values, seen)
+	assert.ElementsMatch(t, []int{101, 102, 103, 104, 105}, table.Snapshot())
+
+	count := 0
+	for range table.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count, "stops when the consumer stops")
+}
+
+func TestHashChainTable_Reserve(t *testing.T) {
+	table := NewHashChainTable[int](4)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, table.Insert(i))
+	}
+
+	// Already large enough: no change
+	table.Reserve(3)
+	assert.Equal(t, int64(4), table.MaxSize)
+
+	table.Reserve(100)
+	assert.GreaterOrEqual(t, table.MaxSize, int64(134))
+	assert.Len(t, table.Table, int(table.MaxSize))
+	assert.Equal(t, 3, table.Size())
+	for i := 0; i < 3; i++ {
+		node, err := table.Search(i)
+		require.NoError(t, err)
+		assert.NotNil(t, node, "value %d should survive the rehash", i)
+	}
+
+	for i := 3; i < 100; i++ {
+		require.NoError(t, table.Insert(i))
+	}
+	assert.LessOrEqual(t, table.Stats().LoadFactor, 0.75)
+
+	// Reserve never shrinks
+	size := table.MaxSize
+	table.Reserve(1)
+	assert.Equal(t, size, table.MaxSize)
+}
+
+func TestHashChainTable_Get(t *testing.T) {
+	table := NewHashChainTable[string](1) // one bucket so every value shares a chain
+	for _, v := range []string{"apple", "banana", "cherry"} {
+		require.NoError(t, table.Insert(v))
+	}
+
+	value, found, err := table.Get("banana")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "banana", value)
+
+	value, found, err = table.Get("grape")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Empty(t, value)
+
+	require.NoError(t, table.Delete("banana"))
+	_, found, err = table.Get("banana")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	t.Run("empty bucket", func(t *testing.T) {
+		empty := NewHashChainTable[int](8)
+		_, found, err := empty.Get(1)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		boolTable := NewHashChainTable[bool](4)
+		_, _, err := boolTable.Get(true)
+		assert.ErrorIs(t, err, ErrorUnsupportedValueType)
+	})
+}
+
+func TestHashChainTable_SearchNode(t *testing.T) {
+	// A constant hash puts every value into the same bucket.
+	table := NewHashChainTableWithHasher(8, func(string) (uint64, error) { return 3, nil })
+	for _, v := range []string{"a", "b", "c"} {
+		require.NoError(t, table.Insert(v))
+	}
+	require.Equal(t, []string{"c", "b", "a"}, table.Table[3].ToSlice())
+
+	node, index, err := table.SearchNode("a")
+	require.NoError(t, err)
+	require.NotNil(t, node)
+	assert.Equal(t, "a", node.Value)
+	assert.Equal(t, 3, index)
+
+	// Move the found entry to the front of its chain through the linked list.
+	bucket := table.Table[index]
+	require.NoError(t, bucket.DeleteNode(node))
+	bucket.Prepend(node.Value)
+	assert.Equal(t, []string{"a", "c", "b"}, bucket.ToSlice())
+	assert.Equal(t, "b", bucket.Tail().Value)
+	assert.Equal(t, 3, table.Size())
+
+	node, index, err = table.SearchNode("z")
+	require.NoError(t, err)
+	assert.Nil(t, node)
+	assert.Equal(t, 3, index, "a miss reports the bucket the value hashes to")
+
+	_, index, err = NewHashChainTable[bool](4).SearchNode(true)
+	assert.ErrorIs(t, err, ErrorUnsupportedValueType)
+	assert.Equal(t, -1, index)
+}
+
+func TestSelfAdjustingHashTable(t *testing.T) {
+	table := NewSelfAdjustingHashTable[string](8)
+	// A constant hash puts every value into the same bucket.
+	table.hashFn = func(string) (uint64, error) { return 5, nil }
+	for _, v := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, table.Insert(v))
+	}
+	bucket := func() []string { return table.Table[5].ToSlice() }
+	require.Equal(t, []string{"d", "c", "b", "a"}, bucket())
+
+	node, err := table.Search("b")
+	require.NoError(t, err)
+	require.NotNil(t, node)
+	assert.Equal(t, "b", node.Value)
+	assert.Same(t, table.Table[5].Head(), node, "Search returns the moved node")
+	assert.Equal(t, []string{"b", "d", "c", "a"}, bucket())
+
+	value, found, err := table.Get("a")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "a", value)
+	assert.Equal(t, []string{"a", "b", "d", "c"}, bucket())
+	assert.Equal(t, "c", table.Table[5].Tail().Value)
+
+	// Hitting the head and missing leave the chain unchanged.
+	_, _, err = table.Get("a")
+	require.NoError(t, err)
+	_, found, err = table.Get("z")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, []string{"a", "b", "d", "c"}, bucket())
+	assert.Equal(t, 4, table.Size())
+
+	// Delete still finds moved values.
+	require.NoError(t, table.Delete("b"))
+	assert.Equal(t, []string{"a", "d", "c"}, bucket())
+}
+
+func TestHashChainTable_SearchDoesNotReorder(t *testing.T) {
+	table := NewHashChainTableWithHasher(8, func(string) (uint64, error) { return 5, nil })
+	for _, v := range []string{"a", "b", "c"} {
+		require.NoError(t, table.Insert(v))
+	}
+	_, err := table.Search("a")
+	require.NoError(t, err)
+	_, _, err = table.Get("b")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "b", "a"}, table.Table[5].ToSlice())
+}
+
+// benchmarkZipfLookups looks up 10000 values drawn from a Zipf distribution in a table
+// with long chains, where a few hot values account for most lookups.
+func benchmarkZipfLookups(b *testing.B, table *HashChainTable[int]) {
+	const n = 10000
+	for i := 0; i < n; i++ {
+		require.NoError(b, table.Insert(i))
+	}
+	zipf := rand.NewZipf(rand.New(rand.NewSource(1)), 1.2, 1, n-1)
+	keys := make([]int, 4096)
+	for i := range keys {
+		// Spread the hot values over the table instead of clustering them at small integers
+		keys[i] = int(zipf.Uint64()*7919) % n
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := table.Get(keys[i%len(keys)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkHashChainTable_ZipfGet(b *testing.B) {
+	benchmarkZipfLookups(b, NewHashChainTable[int](8))
+}
+
+func BenchmarkSelfAdjustingHashTable_ZipfGet(b *testing.B) {
+	benchmarkZipfLookups(b, NewSelfAdjustingHashTable[int](8))
+}
@@ -0,0 +1,185 @@
+package hashtable
+
+import (
+	"sync"
+
+	l "github.com/haru-256/ctci-6th-edition/pkg/linked_list"
+)
+
+// multisetEntry is a distinct value stored in a HashMultiset bucket together with its count.
+type multisetEntry[T comparable] struct {
+	value T
+	count int
+}
+
+// HashMultiset implements a thread-safe multiset (bag) using chaining for collision resolution.
+// Unlike HashChainTable, inserting a value that is already present does not fail;
+// it increments the value's count instead. Each bucket stores one entry per distinct value,
+// so memory grows with the number of distinct values rather than the total count.
+// The bucket array doubles whenever the number of distinct values would exceed a load factor of 0.75.
+type HashMultiset[T comparable] struct {
+	// table is an array of linked lists holding one entry per distinct value
+	table []*l.LinkedList[multisetEntry[T]]
+	// size is the total number of elements, counting duplicates
+	size int
+	// distinct is the number of unique values
+	distinct int
+	// mu provides thread-safe access to the multiset
+	mu sync.RWMutex
+}
+
+// NewHashMultiset creates and returns an empty multiset.
+// Values are hashed with FNV-1a, so int, float64 and string values are supported.
+func NewHashMultiset[T comparable]() *HashMultiset[T] {
+	return &HashMultiset[T]{
+		table: make([]*l.LinkedList[multisetEntry[T]], defaultBuckets),
+	}
+}
+
+// Insert adds one occurrence of value to the multiset.
+// If the value is already present, its count is incremented.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// This method is thread-safe and uses a write lock for concurrent access.
+// Time complexity: O(1) on average.
+func (m *HashMultiset[T]) Insert(value T) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index, err := m.index(value)
+	if err != nil {
+		return err
+	}
+	if node := m.find(index, value); node != nil {
+		node.Value.count++
+		m.size++
+		return nil
+	}
+
+	if float64(m.distinct+1) > defaultLoadFactorThreshold*float64(len(m.table)) {
+		m.grow()
+		// the bucket count changed, so the value's bucket must be recomputed
+		if index, err = m.index(value); err != nil {
+			return err
+		}
+	}
+	if m.table[index] == nil {
+		m.table[index] = l.NewLinkedList[multisetEntry[T]]()
+	}
+	m.table[index].Prepend(multisetEntry[T]{value: value, count: 1})
+	m.size++
+	m.distinct++
+	return nil
+}
+
+// Count returns the number of occurrences of value in the multiset.
+// It returns 0 if the value is absent or its type is not supported for hashing.
+// This method is thread-safe and uses a read lock for concurrent access.
+// Time complexity: O(1) on average.
+func (m *HashMultiset[T]) Count(value T) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	index, err := m.index(value)
+	if err != nil {
+		return 0
+	}
+	if node := m.find(index, value); node != nil {
+		return node.Value.count
+	}
+	return 0
+}
+
+// Delete removes one occurrence of value from the multiset.
+// When the count drops to zero, the value is removed entirely.
+// If the value is not present, it returns ErrorNodeNotFound.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// This method is thread-safe and uses a write lock for concurrent access.
+// Time complexity: O(1) on average.
+func (m *HashMultiset[T]) Delete(value T) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index, err := m.index(value)
+	if err != nil {
+		return err
+	}
+	node := m.find(index, value)
+	if node == nil {
+		return ErrorNodeNotFound
+	}
+
+	m.size--
+	node.Value.count--
+	if node.Value.count > 0 {
+		return nil
+	}
+	if err := m.table[index].DeleteNode(node); err != nil {
+		return err
+	}
+	m.distinct--
+	if m.table[index].Head() == nil { // if list is empty, remove bucket for garbage collection
+		m.table[index] = nil
+	}
+	return nil
+}
+
+// Size returns the total number of elements in the multiset, counting duplicates.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (m *HashMultiset[T]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.size
+}
+
+// Distinct returns the number of unique values in the multiset.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (m *HashMultiset[T]) Distinct() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.distinct
+}
+
+// index returns the bucket index for value.
+// This method assumes the caller already holds the lock.
+func (m *HashMultiset[T]) index(value T) (uint64, error) {
+	hash, err := fnvHash(value)
+	if err != nil {
+		return 0, err
+	}
+	return hash % uint64(len(m.table)), nil
+}
+
+// find returns the node holding value's entry in the given bucket, or nil if there is none.
+// This method assumes the caller already holds the lock.
+func (m *HashMultiset[T]) find(index uint64, value T) *l.Node[multisetEntry[T]] {
+	bucket := m.table[index]
+	if bucket == nil {
+		return nil
+	}
+	for node := bucket.Head(); node != nil; node = node.Next {
+		if node.Value.value == value {
+			return node
+		}
+	}
+	return nil
+}
+
+// grow doubles the number of buckets and redistributes every entry, keeping counts intact.
+// Every stored value was hashed successfully on insert, so rehashing cannot fail.
+// This method assumes the caller already holds the write lock.
+func (m *HashMultiset[T]) grow() {
+	old := m.table
+	m.table = make([]*l.LinkedList[multisetEntry[T]], 2*len(old))
+	for _, bucket := range old {
+		if bucket == nil {
+			continue
+		}
+		bucket.ForEach(func(entry multisetEntry[T]) {
+			index, _ := m.index(entry.value)
+			if m.table[index] == nil {
+				m.table[index] = l.NewLinkedList[multisetEntry[T]]()
+			}
+			m.table[index].Prepend(entry)
+		})
+	}
+}
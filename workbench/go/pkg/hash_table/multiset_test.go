@@ -0,0 +1,96 @@
+package hashtable
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashMultiset_InsertCount(t *testing.T) {
+	m := NewHashMultiset[string]()
+	assert.Equal(t, 0, m.Size())
+	assert.Equal(t, 0, m.Distinct())
+	assert.Equal(t, 0, m.Count("apple"))
+
+	for _, v := range []string{"apple", "banana", "apple", "cherry", "apple", "banana"} {
+		require.NoError(t, m.Insert(v))
+	}
+
+	assert.Equal(t, 3, m.Count("apple"))
+	assert.Equal(t, 2, m.Count("banana"))
+	assert.Equal(t, 1, m.Count("cherry"))
+	assert.Equal(t, 0, m.Count("durian"))
+	assert.Equal(t, 6, m.Size())
+	assert.Equal(t, 3, m.Distinct())
+}
+
+func TestHashMultiset_Delete(t *testing.T) {
+	m := NewHashMultiset[int]()
+	require.NoError(t, m.Insert(7))
+	require.NoError(t, m.Insert(7))
+	require.NoError(t, m.Insert(8))
+
+	require.NoError(t, m.Delete(7))
+	assert.Equal(t, 1, m.Count(7))
+	assert.Equal(t, 2, m.Size())
+	assert.Equal(t, 2, m.Distinct())
+
+	require.NoError(t, m.Delete(7))
+	assert.Equal(t, 0, m.Count(7))
+	assert.Equal(t, 1, m.Size())
+	assert.Equal(t, 1, m.Distinct())
+
+	assert.ErrorIs(t, m.Delete(7), ErrorNodeNotFound)
+	assert.ErrorIs(t, m.Delete(100), ErrorNodeNotFound)
+
+	// a removed value can be inserted again from scratch
+	require.NoError(t, m.Insert(7))
+	assert.Equal(t, 1, m.Count(7))
+}
+
+func TestHashMultiset_UnsupportedType(t *testing.T) {
+	m := NewHashMultiset[bool]()
+	assert.ErrorIs(t, m.Insert(true), ErrorUnsupportedValueType)
+	assert.ErrorIs(t, m.Delete(true), ErrorUnsupportedValueType)
+	assert.Equal(t, 0, m.Count(true))
+	assert.Equal(t, 0, m.Size())
+}
+
+func TestHashMultiset_Grow(t *testing.T) {
+	m := NewHashMultiset[int]()
+	const n = 1000
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i%3; j++ {
+			require.NoError(t, m.Insert(i))
+		}
+	}
+
+	assert.Equal(t, n, m.Distinct())
+	assert.LessOrEqual(t, float64(m.Distinct()), defaultLoadFactorThreshold*float64(len(m.table)))
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i%3+1, m.Count(i), "count of %d", i)
+	}
+}
+
+func TestHashMultiset_Concurrent(t *testing.T) {
+	m := NewHashMultiset[int]()
+	const goroutines, perGoroutine = 8, 500
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				assert.NoError(t, m.Insert(i%50))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, goroutines*perGoroutine, m.Size())
+	assert.Equal(t, 50, m.Distinct())
+	assert.Equal(t, goroutines*perGoroutine/50, m.Count(0))
+}
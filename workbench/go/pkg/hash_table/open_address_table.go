@@ -0,0 +1,219 @@
+package hashtable
+
+import (
+	"math"
+	"sync"
+)
+
+// maxLoadFactor is the load factor (occupied plus deleted slots over capacity)
+// above which HashOpenAddressTable grows and rehashes.
+const maxLoadFactor = 0.7
+
+// slotState describes whether a slot of an open addressing table is in use.
+type slotState uint8
+
+const (
+	slotEmpty     slotState = iota // slot has never been used
+	slotOccupied                   // slot holds a live value
+	slotTombstone                  // slot held a value that was deleted
+)
+
+// slot is a single entry in the backing array of an open addressing table.
+type slot[T comparable] struct {
+	value T
+	state slotState
+}
+
+// HashOpenAddressTable implements a thread-safe hash table using open addressing
+// with linear probing for collision resolution.
+// Deleted entries are marked with tombstones so that probe sequences stay intact.
+// Compared to HashChainTable it avoids per-element pointers, which improves cache locality
+// for small comparable values.
+type HashOpenAddressTable[T comparable] struct {
+	// slots is the backing array probed linearly on collisions
+	slots []slot[T]
+	// MaxSize is the current number of slots in the hash table
+	MaxSize int64
+	// size tracks the number of live elements stored in the hash table
+	size int
+	// tombstones tracks the number of deleted slots that still occupy the probe sequences
+	tombstones int
+	// mu provides thread-safe access to the hash table
+	mu sync.RWMutex
+}
+
+// NewHashOpenAddressTable creates and returns a new open addressing hash table
+// with the specified initial number of slots.
+// The table grows automatically when the load factor exceeds 0.7.
+func NewHashOpenAddressTable[T comparable](maxSize int64) *HashOpenAddressTable[T] {
+	if maxSize <= 0 {
+		panic("hashtable: maxSize must be positive")
+	}
+	return &HashOpenAddressTable[T]{
+		slots:   make([]slot[T], maxSize),
+		MaxSize: maxSize,
+	}
+}
+
+// Size returns the total number of elements currently stored in the hash table.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (table *HashOpenAddressTable[T]) Size() int {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+	return table.size
+}
+
+// Reserve prepares the table to hold expectedElements values without triggering the
+// automatic grow-and-rehash on Insert. If the current number of slots is too small,
+// the table is rehashed once, up front, into a table sized for expectedElements.
+// If the table is already large enough, Reserve does nothing; it never shrinks the table.
+// When only tombstones make the table too full, it is rehashed at its current size,
+// which clears them.
+// This method is thread-safe and uses a write lock for concurrent access.
+func (table *HashOpenAddressTable[T]) Reserve(expectedElements int) {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	if float64(expectedElements+table.tombstones) <= maxLoadFactor*float64(table.MaxSize) {
+		return
+	}
+	table.rehash(max(int64(math.Ceil(float64(expectedElements)/maxLoadFactor)), table.MaxSize))
+}
+
+// Insert adds a new value to the hash table.
+// If the value already exists, it returns ErrorAlreadyExists.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// If the load factor, counting tombstones, would exceed 0.7, the table is rehashed before
+// inserting. It doubles only when the live values need the space (more than half of the
+// 0.7 budget); otherwise it is rehashed at its current size, which just clears the
+// tombstones, so insert/delete churn over a small set of values keeps the table bounded.
+// This method is thread-safe and uses a write lock for concurrent access.
+func (table *HashOpenAddressTable[T]) Insert(value T) error {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	hash, err := table.getHash(value)
+	if err != nil {
+		return err
+	}
+
+	// Look for the value first, so that a duplicate never triggers a rehash.
+	index, found := table.probe(value, hash)
+	if found {
+		return ErrorAlreadyExists
+	}
+
+	limit := maxLoadFactor * float64(table.MaxSize)
+	if float64(table.size+table.tombstones+1) > limit {
+		// Rehashing in place only when the live values fill at most half of the budget
+		// guarantees that many inserts happen before the next rehash.
+		if float64(table.size+1) > limit/2 {
+			table.rehash(table.MaxSize * 2)
+		} else {
+			table.rehash(table.MaxSize)
+		}
+		index, _ = table.probe(value, hash)
+	}
+	if table.slots[index].state == slotTombstone {
+		table.tombstones--
+	}
+	table.slots[index] = slot[T]{value: value, state: slotOccupied}
+	table.size++
+	return nil
+}
+
+// Search reports whether the value is stored in the hash table.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (table *HashOpenAddressTable[T]) Search(value T) (bool, error) {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+
+	hash, err := table.getHash(value)
+	if err != nil {
+		return false, err
+	}
+
+	_, found := table.probe(value, hash)
+	return found, nil
+}
+
+// Delete removes a value from the hash table by replacing it with a tombstone.
+// If the value does not exist, it returns ErrorNodeNotFound.
+// If the value type is not supported for hashing, it returns ErrorUnsupportedValueType.
+// This method is thread-safe and uses a write lock for concurrent access.
+func (table *HashOpenAddressTable[T]) Delete(value T) error {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	hash, err := table.getHash(value)
+	if err != nil {
+		return err
+	}
+
+	index, found := table.probe(value, hash)
+	if !found {
+		return ErrorNodeNotFound
+	}
+
+	var zero T
+	table.slots[index] = slot[T]{value: zero, state: slotTombstone}
+	table.size--
+	table.tombstones++
+	return nil
+}
+
+// probe walks the probe sequence for value starting at its home slot.
+// If the value is found, it returns its index and true.
+// Otherwise it returns the index where the value should be inserted
+// (the first tombstone seen, or the terminating empty slot) and false.
+// This method assumes the caller already holds the appropriate lock.
+func (table *HashOpenAddressTable[T]) probe(value T, hash uint64) (int, bool) {
+	capacity := uint64(table.MaxSize)
+	firstTombstone := -1
+	for i := uint64(0); i < capacity; i++ {
+		index := int((hash + i) % capacity)
+		switch table.slots[index].state {
+		case slotEmpty:
+			if firstTombstone >= 0 {
+				return firstTombstone, false
+			}
+			return index, false
+		case slotTombstone:
+			if firstTombstone < 0 {
+				firstTombstone = index
+			}
+		case slotOccupied:
+			if table.slots[index].value == value {
+				return index, true
+			}
+		}
+	}
+	return firstTombstone, false
+}
+
+// rehash reallocates the backing array with newSize slots and reinserts all live values,
+// discarding tombstones in the process.
+// This method assumes the caller already holds the write lock.
+func (table *HashOpenAddressTable[T]) rehash(newSize int64) {
+	old := table.slots
+	table.slots = make([]slot[T], newSize)
+	table.MaxSize = newSize
+	table.tombstones = 0
+
+	for _, s := range old {
+		if s.state != slotOccupied {
+			continue
+		}
+		// Values already in the table were hashed successfully on insert.
+		hash, _ := table.getHash(s.value)
+		index, _ := table.probe(s.value, hash)
+		table.slots[index] = s
+	}
+}
+
+// getHash computes and returns the FNV-1a hash of a given value.
+// It supports the same value types as HashChainTable.
+func (table *HashOpenAddressTable[T]) getHash(value T) (uint64, error) {
+	return fnvHash(value)
+}
@@ -0,0 +1,235 @@
+package hashtable
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHashOpenAddressTable(t *testing.T) {
+	table := NewHashOpenAddressTable[int](8)
+
+	assert.NotNil(t, table)
+	assert.Equal(t, int64(8), table.MaxSize)
+	assert.Equal(t, 0, table.Size())
+	assert.Len(t, table.slots, 8)
+
+	assert.Panics(t, func() { NewHashOpenAddressTable[int](0) })
+}
+
+func TestHashOpenAddressTable_InsertSearchDelete(t *testing.T) {
+	table := NewHashOpenAddressTable[string](4)
+	values := []string{"apple", "banana", "cherry", "date", "elderberry"}
+
+	for _, v := range values {
+		require.NoError(t, table.Insert(v))
+	}
+	assert.Equal(t, len(values), table.Size())
+	assert.ErrorIs(t, table.Insert("apple"), ErrorAlreadyExists)
+
+	for _, v := range values {
+		found, err := table.Search(v)
+		require.NoError(t, err)
+		assert.True(t, found, "expected to find %s", v)
+	}
+
+	found, err := table.Search("grape")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, table.Delete("banana"))
+	assert.ErrorIs(t, table.Delete("banana"), ErrorNodeNotFound)
+	assert.Equal(t, len(values)-1, table.Size())
+
+	found, err = table.Search("banana")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestHashOpenAddressTable_Tombstones(t *testing.T) {
+	// A large table keeps all values in a single probe run so that
+	// deleting from the middle must not break lookups after it.
+	table := NewHashOpenAddressTable[int](100)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, table.Insert(i))
+	}
+	for i := 0; i < 20; i += 2 {
+		require.NoError(t, table.Delete(i))
+	}
+	for i := 0; i < 20; i++ {
+		found, err := table.Search(i)
+		require.NoError(t, err)
+		assert.Equal(t, i%2 == 1, found, "value %d", i)
+	}
+
+	// Reinserting reuses tombstones instead of growing
+	for i := 0; i < 20; i += 2 {
+		require.NoError(t, table.Insert(i))
+	}
+	assert.Equal(t, 20, table.Size())
+	assert.Equal(t, int64(100), table.MaxSize)
+}
+
+func TestHashOpenAddressTable_Grow(t *testing.T) {
+	table := NewHashOpenAddressTable[int](1)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, table.Insert(i))
+	}
+	assert.Equal(t, 1000, table.Size())
+	assert.LessOrEqual(t, float64(table.Size())/float64(table.MaxSize), maxLoadFactor)
+
+	for i := 0; i < 1000; i++ {
+		found, err := table.Search(i)
+		require.NoError(t, err)
+		assert.True(t, found)
+	}
+}
+
+func TestHashOpenAddressTable_ChurnStaysBounded(t *testing.T) {
+	table := NewHashOpenAddressTable[int](16)
+	// Keep at most 5 live values while inserting and deleting many distinct ones,
+	// so that tombstones, not live values, keep filling the table.
+	for i := 0; i < 10000; i++ {
+		require.NoError(t, table.Insert(i))
+		if i >= 5 {
+			require.NoError(t, table.Delete(i-5))
+		}
+	}
+	assert.Equal(t, 5, table.Size())
+	// 6 live values during an insert exceed half of the 0.7 budget of 16 slots, so the
+	// table doubles once; after that, rehashes only clear tombstones.
+	assert.Equal(t, int64(32), table.MaxSize, "tombstones are cleared instead of doubling the table")
+	for i := 10000 - 5; i < 10000; i++ {
+		found, err := table.Search(i)
+		require.NoError(t, err)
+		assert.True(t, found, "value %d", i)
+	}
+}
+
+func TestHashOpenAddressTable_DuplicateDoesNotGrow(t *testing.T) {
+	table := NewHashOpenAddressTable[int](10)
+	for i := 0; i < 7; i++ {
+		require.NoError(t, table.Insert(i))
+	}
+	require.Equal(t, int64(10), table.MaxSize, "7 values fit exactly at the 0.7 threshold")
+
+	assert.ErrorIs(t, table.Insert(3), ErrorAlreadyExists)
+	assert.Equal(t, int64(10), table.MaxSize, "a rejected duplicate must not rehash")
+	assert.Equal(t, 7, table.Size())
+}
+
+func TestHashOpenAddressTable_UnsupportedType(t *testing.T) {
+	table := NewHashOpenAddressTable[bool](4)
+
+	assert.ErrorIs(t, table.Insert(true), ErrorUnsupportedValueType)
+	_, err := table.Search(true)
+	assert.ErrorIs(t, err, ErrorUnsupportedValueType)
+	assert.ErrorIs(t, table.Delete(true), ErrorUnsupportedValueType)
+}
+
+func TestHashOpenAddressTable_Concurrency(t *testing.T) {
+	table := NewHashOpenAddressTable[int](4)
+
+	var wg sync.WaitGroup
+	wg.Add(10)
+	for i := 0; i < 10; i++ {
+		go func(start int) {
+			defer wg.Done()
+			for j := start * 10; j < (start+1)*10; j++ {
+				assert.NoError(t, table.Insert(j))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, table.Size())
+	for i := 0; i < 100; i++ {
+		found, err := table.Search(i)
+		assert.NoError(t, err)
+		assert.True(t, found)
+	}
+}
+
+// Benchmark tests comparing chaining and open addressing on int keys
+func BenchmarkHashChainTable_InsertInt(b *testing.B) {
+	table := NewHashChainTable[int](1024)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = table.Insert(i)
+	}
+}
+
+func BenchmarkHashOpenAddressTable_InsertInt(b *testing.B) {
+	table := NewHashOpenAddressTable[int](1024)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = table.Insert(i)
+	}
+}
+
+func BenchmarkHashChainTable_SearchInt(b *testing.B) {
+	table := NewHashChainTable[int](1024)
+	for i := 0; i < 1000; i++ {
+		_ = table.Insert(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = table.Search(i % 1000)
+	}
+}
+
+func BenchmarkHashOpenAddressTable_SearchInt(b *testing.B) {
+	table := NewHashOpenAddressTable[int](1024)
+	for i := 0; i < 1000; i++ {
+		_ = table.Insert(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = table.Search(i % 1000)
+	}
+}
+
+func TestHashOpenAddressTable_Reserve(t *testing.T) {
+	table := NewHashOpenAddressTable[int](4)
+	require.NoError(t, table.Insert(1))
+
+	table.Reserve(1000)
+	reserved := table.MaxSize
+	assert.GreaterOrEqual(t, reserved, int64(1429))
+
+	for i := 2; i <= 1000; i++ {
+		require.NoError(t, table.Insert(i))
+	}
+	assert.Equal(t, reserved, table.MaxSize, "no automatic rehash after Reserve")
+	assert.Equal(t, 1000, table.Size())
+
+	table.Reserve(10)
+	assert.Equal(t, reserved, table.MaxSize, "Reserve never shrinks")
+
+	t.Run("tombstones do not shrink the table", func(t *testing.T) {
+		table := NewHashOpenAddressTable[int](100)
+		for i := 0; i < 70; i++ {
+			require.NoError(t, table.Insert(i))
+		}
+		for i := 1; i < 70; i++ {
+			require.NoError(t, table.Delete(i))
+		}
+
+		table.Reserve(2)
+		assert.Equal(t, int64(100), table.MaxSize, "Reserve never shrinks")
+		assert.Equal(t, 1, table.Size())
+		found, err := table.Search(0)
+		require.NoError(t, err)
+		assert.True(t, found)
+		for i := 1; i < 60; i++ {
+			require.NoError(t, table.Insert(i))
+		}
+		assert.Equal(t, int64(100), table.MaxSize, "tombstones were cleared by the rehash")
+	})
+}
@@ -0,0 +1,85 @@
+package hashtable
+
+import (
+	"math"
+)
+
+// defaultBuckets is the number of buckets used by NewHashChainTableWithOptions
+// when no initial capacity is given.
+const defaultBuckets = 16
+
+// options holds the settings applied by NewHashChainTableWithOptions.
+type options[T comparable] struct {
+	initialCapacity     int
+	loadFactorThreshold float64
+	hashFn              func(T) (uint64, error)
+}
+
+// Option configures a HashChainTable created with NewHashChainTableWithOptions.
+type Option[T comparable] func(*options[T])
+
+// WithInitialCapacity returns an Option that sizes the table for about n elements,
+// choosing the number of buckets so that n elements stay within the load factor threshold.
+// Values of n <= 0 keep the default of 16 buckets.
+func WithInitialCapacity[T comparable](n int) Option[T] {
+	return func(o *options[T]) {
+		o.initialCapacity = n
+	}
+}
+
+// WithLoadFactorThreshold returns an Option that sets the load factor (elements over
+// buckets) that WithInitialCapacity and Reserve size the table for. The default is 0.75;
+// lower values trade memory for shorter chains. The threshold must be greater than 0.
+func WithLoadFactorThreshold[T comparable](f float64) Option[T] {
+	return func(o *options[T]) {
+		o.loadFactorThreshold = f
+	}
+}
+
+// WithHasher returns an Option that sets the hash function, as NewHashChainTableWithHasher does.
+// If fn is nil, the default FNV-1a hashing is used.
+func WithHasher[T comparable](fn func(T) (uint64, error)) Option[T] {
+	return func(o *options[T]) {
+		o.hashFn = fn
+	}
+}
+
+// NewHashChainTableWithOptions creates a new hash table configured by opts, so callers can
+// state how many elements they expect instead of choosing a bucket count.
+// Without options it creates a table with 16 buckets, a load factor threshold of 0.75
+// and FNV-1a hashing. Options may be given in any order.
+// It panics if the load factor threshold is not greater than 0.
+//
+// Example:
+//
+//	table := NewHashChainTableWithOptions(
+//		WithInitialCapacity[string](10000),
+//		WithLoadFactorThreshold[string](0.5),
+//	)
+func NewHashChainTableWithOptions[T comparable](opts ...Option[T]) *HashChainTable[T] {
+	o := &options[T]{
+		loadFactorThreshold: defaultLoadFactorThreshold,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if !(o.loadFactorThreshold > 0) {
+		panic("hashtable: load factor threshold must be positive")
+	}
+
+	buckets := int64(defaultBuckets)
+	if o.initialCapacity > 0 {
+		buckets = bucketsFor(o.initialCapacity, o.loadFactorThreshold)
+	}
+
+	table := NewHashChainTable[T](buckets)
+	table.hashFn = o.hashFn
+	table.loadFactorThreshold = o.loadFactorThreshold
+	return table
+}
+
+// bucketsFor returns the smallest bucket count that holds n elements within the
+// given load factor threshold, and at least 1.
+func bucketsFor(n int, threshold float64) int64 {
+	return max(int64(math.Ceil(float64(n)/threshold)), 1)
+}
@@ -0,0 +1,71 @@
+package hashtable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHashChainTableWithOptions(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		table := NewHashChainTableWithOptions[string]()
+		assert.Equal(t, int64(defaultBuckets), table.MaxSize)
+		assert.Equal(t, defaultLoadFactorThreshold, table.loadFactorThreshold)
+		assert.Nil(t, table.hashFn)
+		assert.Equal(t, 0.0, table.LoadFactor())
+	})
+
+	t.Run("initial capacity", func(t *testing.T) {
+		table := NewHashChainTableWithOptions(WithInitialCapacity[int](10000))
+		assert.Equal(t, int64(13334), table.MaxSize)
+
+		for i := 0; i < 10000; i++ {
+			require.NoError(t, table.Insert(i))
+		}
+		assert.LessOrEqual(t, table.LoadFactor(), defaultLoadFactorThreshold)
+	})
+
+	t.Run("options in any order", func(t *testing.T) {
+		a := NewHashChainTableWithOptions(WithInitialCapacity[int](100), WithLoadFactorThreshold[int](0.5))
+		b := NewHashChainTableWithOptions(WithLoadFactorThreshold[int](0.5), WithInitialCapacity[int](100))
+		assert.Equal(t, int64(200), a.MaxSize)
+		assert.Equal(t, a.MaxSize, b.MaxSize)
+	})
+
+	t.Run("threshold is used by Reserve", func(t *testing.T) {
+		table := NewHashChainTableWithOptions(WithLoadFactorThreshold[int](2))
+		table.Reserve(32)
+		assert.Equal(t, int64(defaultBuckets), table.MaxSize, "32 elements fit in 16 buckets at load factor 2")
+		table.Reserve(40)
+		assert.Equal(t, int64(20), table.MaxSize)
+	})
+
+	t.Run("hasher", func(t *testing.T) {
+		table := NewHashChainTableWithOptions(WithHasher(func(b bool) (uint64, error) {
+			if b {
+				return 1, nil
+			}
+			return 0, nil
+		}))
+		require.NoError(t, table.Insert(true))
+		_, found, err := table.Get(true)
+		require.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		assert.Panics(t, func() { NewHashChainTableWithOptions(WithLoadFactorThreshold[int](0)) })
+		assert.Panics(t, func() { NewHashChainTableWithOptions(WithLoadFactorThreshold[int](-1)) })
+	})
+}
+
+func TestHashChainTable_LoadFactor(t *testing.T) {
+	table := NewHashChainTable[int](4)
+	assert.Equal(t, 0.0, table.LoadFactor())
+	for i := 0; i < 6; i++ {
+		require.NoError(t, table.Insert(i))
+	}
+	assert.Equal(t, 1.5, table.LoadFactor())
+	assert.Equal(t, table.Stats().LoadFactor, table.LoadFactor())
+}
@@ -0,0 +1,102 @@
+package heap
+
+import (
+	"context"
+	"sync"
+)
+
+// BlockingHeap is a thread-safe heap whose PopBlocking waits for an element instead of
+// returning ErrorIsEmpty, which makes it the core of a concurrent priority scheduler:
+// workers block in PopBlocking and producers wake one of them with every Insert.
+// Waiting uses a sync.Cond tied to the heap's mutex, and a context bounds the wait.
+// The zero value is not ready to use; use NewBlockingHeap to create a new heap.
+//
+// Time complexity:
+//   - Insert: O(log n)
+//   - Pop/PopBlocking: O(log n), plus any time spent waiting
+//   - Peek/Size: O(1)
+type BlockingHeap[T any] struct {
+	heap *Heap[T]
+	// notEmpty is signalled by Insert; its Locker is heap.mu
+	notEmpty *sync.Cond
+}
+
+// NewBlockingHeap creates and returns a new empty BlockingHeap ordered by cmpFn,
+// which has the same meaning as for NewHeap.
+//
+// Example:
+//
+//	h := heap.NewBlockingHeap(cmputil.Min[int])
+//	go func() { _ = h.Insert(42) }()
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	item, err := h.PopBlocking(ctx) // waits for the insert or the timeout
+func NewBlockingHeap[T any](cmpFn func(a, b *T) int) *BlockingHeap[T] {
+	h := NewHeap(cmpFn)
+	return &BlockingHeap[T]{
+		heap:     h,
+		notEmpty: sync.NewCond(&h.mu),
+	}
+}
+
+// Insert adds a new element to the heap and wakes one goroutine waiting in PopBlocking.
+// Time complexity: O(log n) where n is the number of elements in the heap.
+func (b *BlockingHeap[T]) Insert(item T) error {
+	b.heap.mu.Lock()
+	defer b.heap.mu.Unlock()
+
+	if err := b.heap.insert(item); err != nil {
+		return err
+	}
+	b.notEmpty.Signal()
+	return nil
+}
+
+// Pop removes and returns the top element without waiting.
+// Returns ErrorIsEmpty if the heap is empty.
+// Time complexity: O(log n) where n is the number of elements in the heap.
+func (b *BlockingHeap[T]) Pop() (*T, error) {
+	return b.heap.Pop()
+}
+
+// PopBlocking removes and returns the top element, waiting until one is available or
+// ctx is done. If ctx is done before an element can be taken, nothing is removed and
+// ctx.Err() is returned; this is also the case when ctx is already done on entry, even
+// if the heap is not empty. Use context.WithTimeout to bound the wait.
+// Time complexity: O(log n) where n is the number of elements in the heap, plus any time spent waiting.
+func (b *BlockingHeap[T]) PopBlocking(ctx context.Context) (*T, error) {
+	b.heap.mu.Lock()
+	defer b.heap.mu.Unlock()
+
+	// sync.Cond cannot select on ctx.Done(), so wake every waiter when ctx is done
+	// and let each one re-check its own context.
+	stop := context.AfterFunc(ctx, func() {
+		b.heap.mu.Lock()
+		defer b.heap.mu.Unlock()
+		b.notEmpty.Broadcast()
+	})
+	defer stop()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if len(b.heap.items) > 0 {
+			return b.heap.pop()
+		}
+		b.notEmpty.Wait()
+	}
+}
+
+// Peek returns the top element without removing it.
+// Returns ErrorIsEmpty if the heap is empty.
+// Time complexity: O(1).
+func (b *BlockingHeap[T]) Peek() (*T, error) {
+	return b.heap.Peek()
+}
+
+// Size returns the number of elements currently in the heap.
+// Time complexity: O(1).
+func (b *BlockingHeap[T]) Size() int {
+	return b.heap.Size()
+}
@@ -0,0 +1,150 @@
+package heap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/cmputil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestBlockingHeap_NonBlocking(t *testing.T) {
+	h := NewBlockingHeap(cmputil.Min[int])
+	_, err := h.Pop()
+	assert.ErrorIs(t, err, ErrorIsEmpty)
+	_, err = h.Peek()
+	assert.ErrorIs(t, err, ErrorIsEmpty)
+
+	for _, v := range []int{5, 1, 3} {
+		require.NoError(t, h.Insert(v))
+	}
+	assert.Equal(t, 3, h.Size())
+	top, err := h.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, 1, *top)
+
+	for _, want := range []int{1, 3, 5} {
+		item, err := h.PopBlocking(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, want, *item)
+	}
+	assert.Equal(t, 0, h.Size())
+}
+
+func TestBlockingHeap_PopBlocking(t *testing.T) {
+	t.Run("waits for an insert", func(t *testing.T) {
+		h := NewBlockingHeap(cmputil.Max[int])
+
+		popped := make(chan int)
+		go func() {
+			item, err := h.PopBlocking(context.Background())
+			assert.NoError(t, err)
+			popped <- *item
+		}()
+
+		select {
+		case <-popped:
+			t.Fatal("PopBlocking should block while the heap is empty")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		require.NoError(t, h.Insert(7))
+		assert.Equal(t, 7, <-popped)
+	})
+
+	t.Run("times out on an empty heap", func(t *testing.T) {
+		h := NewBlockingHeap(cmputil.Max[int])
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		item, err := h.PopBlocking(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Nil(t, item)
+	})
+
+	t.Run("cancelled context removes nothing", func(t *testing.T) {
+		h := NewBlockingHeap(cmputil.Max[int])
+		require.NoError(t, h.Insert(1))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := h.PopBlocking(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, h.Size())
+	})
+
+	t.Run("cancelling one waiter leaves the others waiting", func(t *testing.T) {
+		h := NewBlockingHeap(cmputil.Max[int])
+		ctx, cancel := context.WithCancel(context.Background())
+
+		cancelled := make(chan error)
+		go func() {
+			_, err := h.PopBlocking(ctx)
+			cancelled <- err
+		}()
+		popped := make(chan int)
+		go func() {
+			item, err := h.PopBlocking(context.Background())
+			assert.NoError(t, err)
+			popped <- *item
+		}()
+
+		cancel()
+		assert.ErrorIs(t, <-cancelled, context.Canceled)
+		require.NoError(t, h.Insert(9))
+		assert.Equal(t, 9, <-popped)
+	})
+}
+
+func TestBlockingHeap_ProducerConsumer(t *testing.T) {
+	t.Parallel()
+
+	const numProducers = 4
+	const numConsumers = 4
+	const itemsPerProducer = 250
+
+	h := NewBlockingHeap(cmputil.Min[int])
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	results := make(chan int, numProducers*itemsPerProducer)
+	var consumers errgroup.Group
+	for c := 0; c < numConsumers; c++ {
+		consumers.Go(func() error {
+			for i := 0; i < numProducers*itemsPerProducer/numConsumers; i++ {
+				item, err := h.PopBlocking(ctx)
+				if err != nil {
+					return err
+				}
+				results <- *item
+			}
+			return nil
+		})
+	}
+
+	var producers errgroup.Group
+	for p := 0; p < numProducers; p++ {
+		producers.Go(func() error {
+			for j := 0; j < itemsPerProducer; j++ {
+				if err := h.Insert(p*itemsPerProducer + j); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	require.NoError(t, producers.Wait())
+	require.NoError(t, consumers.Wait())
+	close(results)
+
+	seen := make(map[int]bool)
+	for v := range results {
+		require.False(t, seen[v], "item %d popped twice", v)
+		seen[v] = true
+	}
+	assert.Len(t, seen, numProducers*itemsPerProducer)
+	assert.Equal(t, 0, h.Size())
+}
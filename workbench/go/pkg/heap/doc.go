@@ -23,6 +23,8 @@ efficient insertion, extraction, and heap operations.
 - Peek (view top): O(1)
 - BuildHeap: O(n)
 - HeapSort: O(n log n)
+- MedianTracker.Add: O(log n), MedianTracker.Median: O(1)
+- BlockingHeap.PopBlocking: O(log n), plus any time spent waiting
 - Space: O(n)
 
 All operations maintain the heap property efficiently through up-heap and down-heap operations.
@@ -92,6 +94,10 @@ All operations maintain the heap property efficiently through up-heap and down-h
 	customHeap.Insert(25)
 	customHeap.Insert(5)
 
+	// For cmp.Ordered types, the cmputil package provides ready-made comparators
+	maxHeap := heap.NewHeap(cmputil.Max[int])
+	minHeap := heap.NewHeap(cmputil.Reverse(cmputil.Max[int])) // same as cmputil.Min[int]
+
 	// Custom types with comparison
 	type Person struct {
 		Name string
@@ -158,6 +164,68 @@ All operations maintain the heap property efficiently through up-heap and down-h
 	// Note: The heap structure is destroyed after sorting
 	fmt.Println("Heap sorted successfully")
 
+# Copying and Shared Storage
+
+Some calls copy the heap's storage and some share it:
+
+  - Items returns a new slice of element values; nothing is shared with the heap.
+  - GetItems returns a new slice, but the element pointers are shared. Writing through
+    them changes the stored elements without restoring the heap order.
+  - Peek and Pop return the stored element pointer itself.
+  - BuildHeap, BuildMaxHeap, BuildMinHeap and NewHeapWrapping adopt the caller's slice
+    without copying and heapify it in place. HeapSort returns that same slice, sorted.
+
+NewHeapWrapping works for any element type, so it can be used to sort custom types in
+place with the heap's own sift operations:
+
+	people := []*Person{{"Alice", 30}, {"Bob", 25}, {"Charlie", 35}}
+	h := heap.NewHeapWrapping(people, personCmp)
+	// people now holds the same elements in heap order; Charlie is at index 0
+
+All returns an iter.Seq over a snapshot of the element pointers in the same heap order,
+so the top comes first but the rest are unordered; pop to visit elements by priority:
+
+	for item := range h.All() {
+		fmt.Println(*item)
+	}
+
+# Index-Based Access
+
+Schedulers that track elements by heap index can read them with At and change them
+with UpdateAt, which applies a mutation and then moves the element up or down as needed:
+
+	h := heap.NewMinHeap[int]()
+	h.Insert(10)
+	h.Insert(20)
+	elem, err := h.At(1)                          // 20
+	err = h.UpdateAt(1, func(v *int) { *v = 5 }) // 5 moves to the top
+
+# Draining
+
+DrainFunc pops every element in heap order and passes it to a callback under a single
+lock, so concurrent inserts wait for the drain instead of being interleaved:
+
+	err := h.DrainFunc(func(v *int) {
+		fmt.Println(*v)
+	})
+
+# Blocking Pop
+
+BlockingHeap is a heap for concurrent schedulers. Its PopBlocking waits on a sync.Cond
+until an element is available instead of returning ErrorIsEmpty, and every Insert wakes
+one waiter. The wait is bounded by a context; on cancellation or timeout nothing is
+removed and ctx.Err() is returned:
+
+	jobs := heap.NewBlockingHeap(cmputil.Min[int])
+	go func() { _ = jobs.Insert(3) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	job, err := jobs.PopBlocking(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		fmt.Println("no work within a second")
+	}
+
 # Heap Index Calculations
 
 The package provides utility functions for heap index calculations:
@@ -202,6 +270,18 @@ The heap can be used as a priority queue:
 			taskNode.Key, task.Description)
 	}
 
+# Streaming Median
+
+MedianTracker combines a max heap holding the lower half of a stream with a min heap
+holding the upper half. Each Add rebalances the halves in O(log n) and Median reads
+the tops in O(1):
+
+	m := heap.NewMedianTracker[int]()
+	for _, v := range []int{5, 15, 1, 3} {
+		m.Add(v)
+	}
+	median, err := m.Median() // 4 (mean of 3 and 5)
+
 # Memory Management
 
 The heap implementation is designed for efficient memory usage:
@@ -5,8 +5,8 @@
 // Thread Safety:
 // This implementation is thread-safe and can be used concurrently by multiple goroutines.
 // All public methods use appropriate mutex locking:
-//   - Read operations (Peek, Size, GetItems) use RWMutex.RLock() for concurrent reads
-//   - Write operations (Insert, Pop, UpHeap, DownHeap) use RWMutex.Lock() for exclusive access
+//   - Read operations (Peek, At, Size, GetItems, Items) use RWMutex.RLock() for concurrent reads
+//   - Write operations (Insert, Pop, UpdateAt, UpHeap, DownHeap) use RWMutex.Lock() for exclusive access
 //   - Internal methods (upHeap, downHeap) do not acquire locks and should only be called
 //     when the caller already holds the appropriate lock to avoid deadlocks
 package heap
@@ -14,7 +14,10 @@ package heap
 import (
 	"cmp"
 	"errors"
+	"iter"
 	"sync"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/cmputil"
 )
 
 var (
@@ -33,8 +36,8 @@ var (
 // Thread Safety:
 // The Heap is thread-safe for concurrent use by multiple goroutines.
 // It uses sync.RWMutex to coordinate access:
-// - Multiple readers can access read-only operations (Peek, Size, GetItems) concurrently
-// - Write operations (Insert, Pop, UpHeap, DownHeap) acquire exclusive locks
+// - Multiple readers can access read-only operations (Peek, At, Size, GetItems, Items) concurrently
+// - Write operations (Insert, Pop, UpdateAt, UpHeap, DownHeap) acquire exclusive locks
 // - The mutex prevents race conditions and ensures heap consistency across goroutines
 type Heap[T any] struct {
 	items []*T
@@ -61,6 +64,12 @@ func (heap *Heap[T]) Insert(item T) error {
 	heap.mu.Lock()
 	defer heap.mu.Unlock()
 
+	return heap.insert(item)
+}
+
+// insert appends item and moves it up to restore the heap property.
+// This method assumes the caller already holds the write lock.
+func (heap *Heap[T]) insert(item T) error {
 	heap.items = append(heap.items, &item)
 	if err := heap.upHeap(len(heap.items) - 1); err != nil {
 		return err
@@ -73,6 +82,11 @@ func (h *Heap[T]) swap(i, j int) {
 	h.items[i], h.items[j] = h.items[j], h.items[i]
 }
 
+// GetItems returns the heap's element pointers in their internal (heap) order.
+// The returned slice is a copy, but the pointers are shared with the heap: modifying
+// *p changes the stored element without restoring the heap property, so call UpHeap
+// or DownHeap afterwards if the comparison key changed.
+// Time complexity: O(n).
 func (h *Heap[T]) GetItems() []*T {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
@@ -82,6 +96,41 @@ func (h *Heap[T]) GetItems() []*T {
 	return itemsCopy
 }
 
+// All returns an iterator over the heap's element pointers in their internal (heap) order,
+// the same order as GetItems, so the top element comes first but the rest are not sorted.
+// The pointer slice is copied under the read lock when iteration starts, so the loop body
+// may insert or pop and sees the heap as it was at that moment. As with GetItems, the
+// pointers are shared with the heap; use Pop or DrainFunc to visit elements in priority order.
+//
+// Example:
+//
+//	for item := range h.All() {
+//		fmt.Println(*item)
+//	}
+func (h *Heap[T]) All() iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for _, item := range h.GetItems() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Items returns copies of the heap's elements in their internal (heap) order.
+// Unlike GetItems, nothing in the returned slice is shared with the heap.
+// Time complexity: O(n).
+func (h *Heap[T]) Items() []T {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	values := make([]T, len(h.items))
+	for i, item := range h.items {
+		values[i] = *item
+	}
+	return values
+}
+
 // upHeap moves the element at the given index up the heap until the heap property is satisfied.
 // This is an internal method that doesn't acquire locks - it should only be called
 // when the caller already holds the appropriate lock.
@@ -158,6 +207,43 @@ func (h *Heap[T]) downHeapWithSize(index int, heapSize int) error {
 	return nil
 }
 
+// At returns the element at the given index of the heap's internal array.
+// Index 0 is the top of the heap; other indices follow the heap layout (see Left, Right and Parent).
+// The returned pointer is shared with the heap: to change the element, use UpdateAt so that
+// the heap property is restored.
+// Returns ErrorIndexOutOfRange if index is not in [0, Size()).
+// Time complexity: O(1).
+func (h *Heap[T]) At(index int) (*T, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if index < 0 || index >= len(h.items) {
+		return nil, ErrorIndexOutOfRange
+	}
+	return h.items[index], nil
+}
+
+// UpdateAt calls mutate on the element at the given index and then restores the heap
+// property from that index, moving the element up if it now outranks its parent and
+// down otherwise. This is how a scheduler changes the priority of an element it tracks
+// by index.
+// Returns ErrorIndexOutOfRange if index is not in [0, Size()); mutate is not called in that case.
+// Time complexity: O(log n) where n is the number of elements in the heap.
+func (h *Heap[T]) UpdateAt(index int, mutate func(*T)) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if index < 0 || index >= len(h.items) {
+		return ErrorIndexOutOfRange
+	}
+	mutate(h.items[index])
+
+	if index > 0 && h.cmpFn(h.items[Parent(index)], h.items[index]) < 0 {
+		return h.upHeap(index)
+	}
+	return h.downHeap(index)
+}
+
 // Pop removes and returns the top element from the heap.
 // For a max heap, this returns the maximum element.
 // For a min heap, this returns the minimum element.
@@ -169,6 +255,32 @@ func (h *Heap[T]) Pop() (*T, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	return h.pop()
+}
+
+// DrainFunc pops every element in heap order and calls fn with each one until the heap
+// is empty, e.g. to process the remaining work once during a graceful shutdown.
+// The write lock is held for the whole drain, so elements inserted concurrently are
+// never interleaved: they wait until the drain has finished. fn must not call back into
+// the heap, or it will deadlock.
+// Time complexity: O(n log n) where n is the number of elements in the heap.
+func (h *Heap[T]) DrainFunc(fn func(*T)) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for len(h.items) > 0 {
+		item, err := h.pop()
+		if err != nil {
+			return err
+		}
+		fn(item)
+	}
+	return nil
+}
+
+// pop removes and returns the top element from the heap.
+// This method assumes the caller already holds the write lock.
+func (h *Heap[T]) pop() (*T, error) {
 	if len(h.items) == 0 {
 		return nil, ErrorIsEmpty
 	}
@@ -247,21 +359,45 @@ func Parent(i int) int {
 func BuildHeap[T cmp.Ordered](arr []*T, cmpFn func(a, b *T) int) (*Heap[T], error) {
 	heap := NewHeap(cmpFn)
 	heap.items = arr
-	size := heap.Size()
+	if err := heap.heapify(); err != nil {
+		return nil, err
+	}
+	return heap, nil
+}
+
+// NewHeapWrapping creates a heap that adopts items as its backing storage without copying.
+// The slice is heapified in place, so after the call items holds the elements in heap order.
+// The heap and the caller share the same backing array until the heap grows past its
+// capacity on Insert; the caller should not modify items while the heap is in use.
+// Unlike BuildHeap, T may be any type.
+// Time complexity: O(n) where n is the number of elements.
+func NewHeapWrapping[T any](items []*T, cmpFn func(a, b *T) int) *Heap[T] {
+	heap := NewHeap(cmpFn)
+	heap.items = items
+	// heapify only fails on an out-of-range index, which cannot happen here.
+	_ = heap.heapify()
+	return heap
+}
+
+// heapify restores the heap property over all items by sifting down every non-leaf node,
+// starting from the last parent and working upwards.
+// This is an internal method that doesn't acquire locks.
+func (h *Heap[T]) heapify() error {
+	size := len(h.items)
 	for i := size/2 - 1; i >= 0; i-- {
-		if err := heap.downHeapWithSize(i, size); err != nil {
-			return nil, err
+		if err := h.downHeapWithSize(i, size); err != nil {
+			return err
 		}
 	}
-	return heap, nil
+	return nil
 }
 
 func BuildMaxHeap[T cmp.Ordered](arr []*T) (*Heap[T], error) {
-	return BuildHeap(arr, maxCmp[T])
+	return BuildHeap(arr, cmputil.Max[T])
 }
 
 func BuildMinHeap[T cmp.Ordered](arr []*T) (*Heap[T], error) {
-	return BuildHeap(arr, minCmp[T])
+	return BuildHeap(arr, cmputil.Min[T])
 }
 
 // HeapSort sorts the elements in the heap using the heap sort algorithm.
@@ -310,29 +446,11 @@ type Node[K cmp.Ordered, V any] struct {
 // NewMaxHeap creates a new max heap for ordered types.
 // This is a convenience function for creating max heaps with ordered types.
 func NewMaxHeap[T cmp.Ordered]() *Heap[T] {
-	return NewHeap(maxCmp[T])
+	return NewHeap(cmputil.Max[T])
 }
 
 // NewMinHeap creates a new min heap for ordered types.
 // This is a convenience function for creating min heaps with ordered types.
 func NewMinHeap[T cmp.Ordered]() *Heap[T] {
-	return NewHeap(minCmp[T])
-}
-
-func maxCmp[T cmp.Ordered](a, b *T) int {
-	if *a > *b {
-		return 1
-	} else if *a < *b {
-		return -1
-	}
-	return 0
-}
-
-func minCmp[T cmp.Ordered](a, b *T) int {
-	if *a < *b {
-		return 1
-	} else if *a > *b {
-		return -1
-	}
-	return 0
+	return NewHeap(cmputil.Min[T])
 }
@@ -2,45 +2,17 @@ package heap
 
 import (
 	"context"
+	"slices"
 	"testing"
 
+	"github.com/haru-256/ctci-6th-edition/pkg/cmputil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 )
 
-// intCmp compares two integers for max heap (larger values have higher priority)
-func intCmp(a, b *int) int {
-	if *a > *b {
-		return 1
-	} else if *a < *b {
-		return -1
-	}
-	return 0
-}
-
-// stringCmp compares two strings lexicographically for max heap
-func stringCmp(a, b *string) int {
-	if *a > *b {
-		return 1
-	} else if *a < *b {
-		return -1
-	}
-	return 0
-}
-
-// float64Cmp compares two float64 values for max heap
-func float64Cmp(a, b *float64) int {
-	if *a > *b {
-		return 1
-	} else if *a < *b {
-		return -1
-	}
-	return 0
-}
-
 func TestNewHeap(t *testing.T) {
-	heap := NewHeap[int](intCmp)
+	heap := NewHeap[int](cmputil.Max[int])
 
 	require.NotNil(t, heap, "NewHeap should not return nil")
 	assert.Equal(t, 0, heap.Size(), "Expected size 0")
@@ -88,7 +60,7 @@ func TestMinHeap_Operations(t *testing.T) {
 }
 
 func TestHeap_Insert(t *testing.T) {
-	heap := NewHeap[int](intCmp)
+	heap := NewHeap[int](cmputil.Max[int])
 
 	// Test inserting single element
 	require.NoError(t, heap.Insert(10))
@@ -384,7 +356,7 @@ func TestHeapSort_SingleElement(t *testing.T) {
 
 func TestMaxHeap_WithDifferentTypes(t *testing.T) {
 	// Test with string values
-	stringHeap := NewHeap[string](stringCmp)
+	stringHeap := NewHeap[string](cmputil.Max[string])
 	require.NoError(t, stringHeap.Insert("zebra"))
 	require.NoError(t, stringHeap.Insert("apple"))
 	require.NoError(t, stringHeap.Insert("banana"))
@@ -395,7 +367,7 @@ func TestMaxHeap_WithDifferentTypes(t *testing.T) {
 	assert.Equal(t, "zebra", *max, "Expected max string 'zebra'")
 
 	// Test with float64 values
-	floatHeap := NewHeap[float64](float64Cmp)
+	floatHeap := NewHeap[float64](cmputil.Max[float64])
 	require.NoError(t, floatHeap.Insert(3.14))
 	require.NoError(t, floatHeap.Insert(2.71))
 	require.NoError(t, floatHeap.Insert(1.41))
@@ -775,3 +747,220 @@ func verifyHeapIntegrityAfterConcurrency(t *testing.T, heap *Heap[int]) {
 	require.NotNil(t, poppedMax, "Popped value should not be nil")
 	assert.Equal(t, 9999, *poppedMax, "Should pop the maximum value")
 }
+
+func TestHeap_ItemsAndGetItems(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{3, 1, 4, 1, 5} {
+		require.NoError(t, h.Insert(v))
+	}
+
+	values := h.Items()
+	pointers := h.GetItems()
+	require.Len(t, values, 5)
+	require.Len(t, pointers, 5)
+	assert.Equal(t, 5, values[0])
+	for i := range values {
+		assert.Equal(t, values[i], *pointers[i])
+	}
+
+	// Items returns copies: changing them does not touch the heap.
+	values[0] = 100
+	top, err := h.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, 5, *top)
+
+	// GetItems shares element pointers with the heap.
+	*pointers[0] = 50
+	top, err = h.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, 50, *top)
+}
+
+func TestHeap_All(t *testing.T) {
+	h := NewMaxHeap[int]()
+	assert.Empty(t, slices.Collect(h.All()))
+
+	for _, v := range []int{3, 9, 1, 7} {
+		require.NoError(t, h.Insert(v))
+	}
+	items := slices.Collect(h.All())
+	require.Len(t, items, 4)
+	assert.Equal(t, 9, *items[0], "the top element comes first")
+	assert.Equal(t, h.GetItems(), items, "same heap order and pointers as GetItems")
+
+	// The loop body may pop; iteration sees the elements at the start.
+	var seen []int
+	for item := range h.All() {
+		seen = append(seen, *item)
+		_, err := h.Pop()
+		require.NoError(t, err)
+	}
+	assert.ElementsMatch(t, []int{1, 3, 7, 9}, seen)
+	assert.Equal(t, 0, h.Size())
+}
+
+func TestNewHeapWrapping(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	taskCmp := func(a, b *task) int { return a.priority - b.priority }
+
+	items := []*task{{"a", 2}, {"b", 9}, {"c", 4}, {"d", 7}}
+	h := NewHeapWrapping(items, taskCmp)
+
+	// The caller's slice was heapified in place.
+	assert.Equal(t, "b", items[0].name)
+	assert.Equal(t, 4, h.Size())
+
+	// The heap adopted the slice instead of copying it.
+	items[0].priority = 10
+	top, err := h.Peek()
+	require.NoError(t, err)
+	assert.Same(t, items[0], top)
+
+	var order []string
+	for h.Size() > 0 {
+		item, err := h.Pop()
+		require.NoError(t, err)
+		order = append(order, item.name)
+	}
+	assert.Equal(t, []string{"b", "d", "c", "a"}, order)
+
+	empty := NewHeapWrapping([]*task{}, taskCmp)
+	assert.Equal(t, 0, empty.Size())
+}
+
+func TestHeap_At(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for _, v := range []int{3, 9, 5} {
+		require.NoError(t, h.Insert(v))
+	}
+
+	top, err := h.At(0)
+	require.NoError(t, err)
+	assert.Equal(t, 9, *top)
+
+	items := h.GetItems()
+	for i := range items {
+		item, err := h.At(i)
+		require.NoError(t, err)
+		assert.Same(t, items[i], item)
+	}
+
+	_, err = h.At(-1)
+	assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+	_, err = h.At(3)
+	assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+}
+
+func TestHeap_UpdateAt(t *testing.T) {
+	// isHeap reports whether every parent outranks or equals its children.
+	isHeap := func(h *Heap[int]) bool {
+		items := h.GetItems()
+		for i := 1; i < len(items); i++ {
+			if *items[Parent(i)] < *items[i] {
+				return false
+			}
+		}
+		return true
+	}
+	build := func() *Heap[int] {
+		h := NewMaxHeap[int]()
+		for _, v := range []int{50, 40, 30, 20, 10, 5, 1} {
+			require.NoError(t, h.Insert(v))
+		}
+		return h
+	}
+
+	t.Run("increase moves up", func(t *testing.T) {
+		h := build()
+		last := h.Size() - 1
+		require.NoError(t, h.UpdateAt(last, func(v *int) { *v = 100 }))
+		top, err := h.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, 100, *top)
+		assert.True(t, isHeap(h))
+	})
+
+	t.Run("decrease moves down", func(t *testing.T) {
+		h := build()
+		require.NoError(t, h.UpdateAt(0, func(v *int) { *v = 0 }))
+		top, err := h.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, 40, *top)
+		assert.True(t, isHeap(h))
+	})
+
+	t.Run("pop order after updates", func(t *testing.T) {
+		h := build()
+		require.NoError(t, h.UpdateAt(1, func(v *int) { *v = 2 }))
+		require.NoError(t, h.UpdateAt(4, func(v *int) { *v = 45 }))
+		var got []int
+		for h.Size() > 0 {
+			v, err := h.Pop()
+			require.NoError(t, err)
+			got = append(got, *v)
+		}
+		assert.Equal(t, []int{50, 45, 30, 20, 5, 2, 1}, got)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		h := build()
+		called := false
+		err := h.UpdateAt(7, func(*int) { called = true })
+		assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+		assert.False(t, called)
+	})
+}
+
+func TestHeap_DrainFunc(t *testing.T) {
+	h := NewMinHeap[int]()
+	for _, v := range []int{5, 3, 8, 1, 9, 2} {
+		require.NoError(t, h.Insert(v))
+	}
+
+	var drained []int
+	require.NoError(t, h.DrainFunc(func(v *int) {
+		drained = append(drained, *v)
+	}))
+	assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, drained)
+	assert.Equal(t, 0, h.Size())
+
+	called := false
+	require.NoError(t, h.DrainFunc(func(*int) { called = true }))
+	assert.False(t, called, "draining an empty heap does not call fn")
+}
+
+func TestHeap_DrainFuncConcurrentInsert(t *testing.T) {
+	h := NewMaxHeap[int]()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, h.Insert(i))
+	}
+
+	started := make(chan struct{})
+	g, _ := errgroup.WithContext(context.Background())
+	var drained []int
+	g.Go(func() error {
+		return h.DrainFunc(func(v *int) {
+			if len(drained) == 0 {
+				close(started)
+			}
+			drained = append(drained, *v)
+		})
+	})
+	g.Go(func() error {
+		<-started
+		return h.Insert(1000)
+	})
+	require.NoError(t, g.Wait())
+
+	// The concurrent insert waits for the drain, so it is neither interleaved nor lost.
+	require.Len(t, drained, 100)
+	for i, v := range drained {
+		assert.Equal(t, 99-i, v)
+	}
+	top, err := h.Pop()
+	require.NoError(t, err)
+	assert.Equal(t, 1000, *top)
+}
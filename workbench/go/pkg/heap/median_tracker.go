@@ -0,0 +1,96 @@
+package heap
+
+import (
+	"sync"
+)
+
+// Number is the set of ordered numeric types whose values can be converted to float64.
+// It is a subset of cmp.Ordered that excludes strings, which have no numeric median.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// MedianTracker maintains the median of a stream of values.
+// It keeps the lower half of the values in a max heap and the upper half in a min heap,
+// with the lower half holding the same number of values as the upper half or one more.
+// The median is then the top of the lower half, or the mean of both tops.
+//
+// Thread Safety:
+// The MedianTracker is thread-safe for concurrent use by multiple goroutines.
+// Add acquires an exclusive lock; Median and Len acquire a shared lock.
+//
+// Time complexities:
+//   - Add: O(log n)
+//   - Median: O(1)
+//   - Len: O(1)
+//
+// Space complexity: O(n) where n is the number of values added.
+type MedianTracker[T Number] struct {
+	lower *Heap[T] // max heap holding the smaller half of the values
+	upper *Heap[T] // min heap holding the larger half of the values
+	mu    sync.RWMutex
+}
+
+// NewMedianTracker creates and returns a new, empty MedianTracker.
+func NewMedianTracker[T Number]() *MedianTracker[T] {
+	return &MedianTracker[T]{
+		lower: NewMaxHeap[T](),
+		upper: NewMinHeap[T](),
+	}
+}
+
+// Add inserts a value into the stream and rebalances the two halves.
+// Time complexity: O(log n) where n is the number of values added.
+func (m *MedianTracker[T]) Add(value T) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if top, err := m.lower.Peek(); err != nil || value <= *top {
+		_ = m.lower.Insert(value)
+	} else {
+		_ = m.upper.Insert(value)
+	}
+
+	// Restore the size invariant: len(lower) == len(upper) or len(upper)+1.
+	// Both heaps are non-empty whenever a move is needed, so Pop cannot fail.
+	if m.lower.Size() > m.upper.Size()+1 {
+		moved, _ := m.lower.Pop()
+		_ = m.upper.Insert(*moved)
+	} else if m.upper.Size() > m.lower.Size() {
+		moved, _ := m.upper.Pop()
+		_ = m.lower.Insert(*moved)
+	}
+}
+
+// Median returns the median of all values added so far. For an even number of values
+// it is the mean of the two middle values.
+// Returns ErrorIsEmpty if no value has been added.
+// Time complexity: O(1).
+func (m *MedianTracker[T]) Median() (float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	low, err := m.lower.Peek()
+	if err != nil {
+		return 0, err
+	}
+	if m.lower.Size() > m.upper.Size() {
+		return float64(*low), nil
+	}
+	high, err := m.upper.Peek()
+	if err != nil {
+		return 0, err
+	}
+	return (float64(*low) + float64(*high)) / 2, nil
+}
+
+// Len returns the number of values added so far.
+// Time complexity: O(1).
+func (m *MedianTracker[T]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lower.Size() + m.upper.Size()
+}
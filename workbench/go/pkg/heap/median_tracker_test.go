@@ -0,0 +1,110 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+// naiveMedian computes the median of values by sorting a copy.
+func naiveMedian(values []int) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return float64(sorted[n/2])
+	}
+	return (float64(sorted[n/2-1]) + float64(sorted[n/2])) / 2
+}
+
+func TestMedianTracker(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int
+		medians []float64 // median after each Add
+	}{
+		{
+			name:    "ascending",
+			values:  []int{1, 2, 3, 4, 5},
+			medians: []float64{1, 1.5, 2, 2.5, 3},
+		},
+		{
+			name:    "descending",
+			values:  []int{5, 4, 3, 2, 1},
+			medians: []float64{5, 4.5, 4, 3.5, 3},
+		},
+		{
+			name:    "duplicates and negatives",
+			values:  []int{-3, 7, 7, -3, 0},
+			medians: []float64{-3, 2, 7, 2, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMedianTracker[int]()
+			for i, v := range tt.values {
+				m.Add(v)
+				median, err := m.Median()
+				require.NoError(t, err)
+				assert.Equal(t, tt.medians[i], median, "after adding %v", tt.values[:i+1])
+			}
+			assert.Equal(t, len(tt.values), m.Len())
+		})
+	}
+}
+
+func TestMedianTracker_Empty(t *testing.T) {
+	m := NewMedianTracker[float64]()
+	_, err := m.Median()
+	assert.ErrorIs(t, err, ErrorIsEmpty)
+	assert.Equal(t, 0, m.Len())
+
+	m.Add(2.5)
+	median, err := m.Median()
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, median)
+}
+
+func TestMedianTracker_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewMedianTracker[int]()
+	var values []int
+	for i := 0; i < 500; i++ {
+		v := r.Intn(100) - 50
+		values = append(values, v)
+		m.Add(v)
+		median, err := m.Median()
+		require.NoError(t, err)
+		require.Equal(t, naiveMedian(values), median, "after %d values", i+1)
+	}
+}
+
+func TestMedianTracker_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	m := NewMedianTracker[int]()
+	var g errgroup.Group
+	for i := 0; i < 8; i++ {
+		i := i
+		g.Go(func() error {
+			for j := 0; j < 100; j++ {
+				m.Add(i*100 + j)
+				if _, err := m.Median(); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait())
+
+	assert.Equal(t, 800, m.Len())
+	median, err := m.Median()
+	require.NoError(t, err)
+	assert.Equal(t, 399.5, median)
+}
@@ -21,7 +21,7 @@ pointers for optimal performance at both ends of the list.
 This implementation is thread-safe and can be used concurrently by multiple goroutines.
 All public methods use appropriate mutex locking:
 - Read operations (Search) use RWMutex.RLock() for concurrent reads
-- Write operations (Prepend, Insert, Delete) use RWMutex.Lock() for exclusive access
+- Write operations (Prepend, Append, Insert, Delete) use RWMutex.Lock() for exclusive access
 - The mutex prevents race conditions and ensures list consistency across goroutines
 
 No external synchronization is required when using this linked list from multiple goroutines.
@@ -29,8 +29,17 @@ No external synchronization is required when using this linked list from multipl
 # Performance Characteristics
 
 - Prepend: O(1)
+- Append: O(1)
 - Insert after known node: O(1)
-- Delete known node: O(1)
+- InsertBefore known node: O(1)
+- DeleteNode (delete known node): O(1)
+- Len: O(1)
+- Reverse: O(n) time, O(1) extra space
+- Get by index: O(n), walking from the closer end
+- ToSlice: O(n)
+- Concat: O(1), transferring ownership of the other list's nodes
+- Clone: O(n)
+- RemoveDuplicates: O(n) time with a map; RemoveDuplicatesNoBuffer: O(n^2) time, O(1) space
 - Search: O(n)
 - Delete by value: O(n) due to search phase
 - Space: O(n)
@@ -48,6 +57,10 @@ The list excels at scenarios where frequent insertion/deletion is needed with kn
 	list.Prepend(10)
 	// List now contains: 10 <-> 20 <-> 30
 
+	// Add elements to the end
+	list.Append(40)
+	// List now contains: 10 <-> 20 <-> 30 <-> 40
+
 	// Search for elements
 	node := list.Search(20)
 	if node != nil {
@@ -61,14 +74,31 @@ The list excels at scenarios where frequent insertion/deletion is needed with kn
 			log.Fatal(err)
 		}
 	}
-	// List now contains: 10 <-> 20 <-> 25 <-> 30
+	// List now contains: 10 <-> 20 <-> 25 <-> 30 <-> 40
 
 	// Delete by value
 	err := list.Delete(20)
 	if err != nil {
 		log.Fatal(err)
 	}
-	// List now contains: 10 <-> 25 <-> 30
+	// List now contains: 10 <-> 25 <-> 30 <-> 40
+
+# Functional Helpers
+
+ForEach, Filter and Map traverse the list once from head to tail and preserve order.
+Filter and Map return new lists and never mutate the source:
+
+	evens := list.Filter(func(v int) bool { return v%2 == 0 })
+	labels := linked_list.Map(list, func(v int) string { return fmt.Sprint(v) })
+	evens.ForEach(func(v int) { fmt.Println(v) })
+
+All returns an iter.Seq over a snapshot of the values, so the list works with range-over-func
+and with the collections package:
+
+	for v := range list.All() {
+		fmt.Println(v)
+	}
+	total := collections.Reduce(list.All(), 0, func(acc, v int) int { return acc + v })
 
 # Concurrent Usage
 
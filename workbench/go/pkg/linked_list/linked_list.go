@@ -2,6 +2,7 @@ package linked_list
 
 import (
 	"errors"
+	"iter"
 	"sync"
 )
 
@@ -10,6 +11,8 @@ var (
 	ErrorNodeIsNil = errors.New("node is nil")
 	// ErrorNodeNotFound is returned when a delete operation cannot find the target node.
 	ErrorNodeNotFound = errors.New("node not found")
+	// ErrorIndexOutOfRange is returned when an index is outside the bounds of the list.
+	ErrorIndexOutOfRange = errors.New("index out of range")
 )
 
 // LinkedList represents a generic doubly linked list.
@@ -20,6 +23,7 @@ var (
 type LinkedList[T comparable] struct {
 	head  *Node[T]     // Points to the first node in the list
 	tail  *Node[T]     // Points to the last node in the list
+	size  int          // Number of nodes currently in the list
 	mutex sync.RWMutex // Protects list operations for thread safety
 }
 
@@ -45,6 +49,15 @@ func (l *LinkedList[T]) Tail() *Node[T] {
 	return l.tail
 }
 
+// Len returns the number of nodes in the list.
+// The length is tracked on every insertion and deletion, so this is an O(1) operation.
+// This method is thread-safe and uses read locking.
+func (l *LinkedList[T]) Len() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.size
+}
+
 // Search traverses the list from head to tail looking for a node with the specified value.
 // It returns a pointer to the first node found with the matching value, or nil if not found.
 // The search performs a linear traversal with O(n) time complexity.
@@ -80,6 +93,19 @@ func (l *LinkedList[T]) Prepend(value T) {
 		l.head.Prev = newNode
 		l.head = newNode
 	}
+	l.size++
+}
+
+// Append adds a new node with the specified value to the end of the list.
+// If the list is empty, the new node becomes both head and tail.
+// Otherwise, the new node is linked after the current tail and becomes the new tail.
+// Together with Prepend this allows O(1) insertion at both ends, e.g. for FIFO usage.
+// This operation has O(1) time complexity and is thread-safe using exclusive locking.
+func (l *LinkedList[T]) Append(value T) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.appendNode(NewNode(value))
 }
 
 // Insert adds a new node with the specified value immediately after the given node.
@@ -103,6 +129,7 @@ func (l *LinkedList[T]) Insert(value T, after *Node[T]) error {
 	}
 	after.Next = newNode
 	newNode.Prev = after
+	l.size++
 
 	return nil
 }
@@ -134,6 +161,14 @@ func (l *LinkedList[T]) Delete(value T) error {
 	if node == nil {
 		return ErrorNodeNotFound
 	}
+	l.unlink(node)
+
+	return nil
+}
+
+// unlink detaches the given node from the list, updating head and tail as needed.
+// This method assumes the caller already holds the write lock and that node belongs to the list.
+func (l *LinkedList[T]) unlink(node *Node[T]) {
 	if node.Prev != nil {
 		node.Prev.Next = node.Next
 	} else {
@@ -148,6 +183,284 @@ func (l *LinkedList[T]) Delete(value T) error {
 	// Help GC by breaking references from the deleted node.
 	node.Prev = nil
 	node.Next = nil
+	l.size--
+}
+
+// InsertBefore adds a new node with the specified value immediately before the given node.
+// The 'before' parameter must not be nil, or ErrorNodeIsNil will be returned.
+// If 'before' is the current head, the new node becomes the new head.
+// This operation maintains all doubly-linked relationships and has O(1) time complexity.
+// This method is thread-safe using exclusive locking.
+func (l *LinkedList[T]) InsertBefore(value T, before *Node[T]) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if before == nil {
+		return ErrorNodeIsNil
+	}
+	newNode := NewNode(value)
+	if before.Prev != nil {
+		before.Prev.Next = newNode
+		newNode.Prev = before.Prev
+	} else {
+		l.head = newNode
+	}
+	before.Prev = newNode
+	newNode.Next = before
+	l.size++
+
+	return nil
+}
+
+// DeleteNode removes the given node from the list without searching by value,
+// which is useful when values are duplicated or the node reference is already known.
+// It returns ErrorNodeIsNil if node is nil, and ErrorNodeNotFound if the node is
+// detectably not linked into this list (e.g. it was already deleted).
+// This operation has O(1) time complexity and is thread-safe using exclusive locking.
+func (l *LinkedList[T]) DeleteNode(node *Node[T]) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if node == nil {
+		return ErrorNodeIsNil
+	}
+	if (node.Prev == nil && l.head != node) || (node.Next == nil && l.tail != node) {
+		return ErrorNodeNotFound
+	}
+	l.unlink(node)
 
 	return nil
 }
+
+// Reverse reverses the order of the list in place.
+// Each node's Next and Prev pointers are swapped and the head and tail are exchanged,
+// so forward traversal afterwards yields the previous backward order.
+// This operation has O(n) time complexity, O(1) extra space, and is thread-safe using exclusive locking.
+func (l *LinkedList[T]) Reverse() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	current := l.head
+	for current != nil {
+		current.Next, current.Prev = current.Prev, current.Next
+		current = current.Prev // the former Next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// Get returns the node at the specified 0-based index.
+// It returns ErrorIndexOutOfRange if index is negative or not less than Len().
+// The traversal starts from whichever end is closer, so it takes at most n/2 steps.
+// This method is thread-safe and uses read locking.
+func (l *LinkedList[T]) Get(index int) (*Node[T], error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	if index < 0 || index >= l.size {
+		return nil, ErrorIndexOutOfRange
+	}
+	if index < l.size/2 {
+		current := l.head
+		for i := 0; i < index; i++ {
+			current = current.Next
+		}
+		return current, nil
+	}
+	current := l.tail
+	for i := l.size - 1; i > index; i-- {
+		current = current.Prev
+	}
+	return current, nil
+}
+
+// ToSlice returns the values of the list from head to tail as a new slice.
+// An empty list returns an empty slice.
+// This operation has O(n) time complexity and is thread-safe using read locking.
+func (l *LinkedList[T]) ToSlice() []T {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	values := make([]T, 0, l.size)
+	for current := l.head; current != nil; current = current.Next {
+		values = append(values, current.Value)
+	}
+	return values
+}
+
+// All returns an iterator over the values of the list from head to tail, for use with
+// range-over-func and the collections package.
+// The values are copied when iteration starts, so the loop body may modify the list and
+// sees the list as it was at that moment.
+// This operation has O(n) time complexity and is thread-safe using read locking.
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range l.ToSlice() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// HasCycle reports whether following Next pointers from the head ever loops back
+// to a previously visited node, which indicates a corrupted list.
+// It uses Floyd's tortoise-and-hare algorithm with O(n) time and O(1) extra space.
+// This method is thread-safe and uses read locking.
+func (l *LinkedList[T]) HasCycle() bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	slow, fast := l.head, l.head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return true
+		}
+	}
+	return false
+}
+
+// ForEach calls fn for each value in the list from head to tail.
+// The read lock is held for the whole traversal, so fn must not modify the list.
+// This method is thread-safe and uses read locking.
+func (l *LinkedList[T]) ForEach(fn func(T)) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	for current := l.head; current != nil; current = current.Next {
+		fn(current.Value)
+	}
+}
+
+// Filter returns a new list containing, in order, the values for which pred returns true.
+// The receiver is not modified. The read lock is held for the whole traversal,
+// so pred must not modify the list.
+// This method is thread-safe and uses read locking.
+func (l *LinkedList[T]) Filter(pred func(T) bool) *LinkedList[T] {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	result := NewLinkedList[T]()
+	for current := l.head; current != nil; current = current.Next {
+		if pred(current.Value) {
+			result.appendNode(NewNode(current.Value))
+		}
+	}
+	return result
+}
+
+// Map returns a new list containing fn applied to each value of list, preserving order.
+// It is a package-level function because Go methods cannot declare additional type parameters.
+// The source list is not modified. Its read lock is held for the whole traversal,
+// so fn must not modify the list.
+func Map[T, U comparable](list *LinkedList[T], fn func(T) U) *LinkedList[U] {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+
+	result := NewLinkedList[U]()
+	for current := list.head; current != nil; current = current.Next {
+		result.appendNode(NewNode(fn(current.Value)))
+	}
+	return result
+}
+
+// Concat splices all nodes of other onto the end of the receiver in O(1) time.
+// Ownership of the nodes is transferred: other is left empty afterwards.
+// Concatenating a list with itself (or with nil) is a no-op.
+// Both lists are locked exclusively for the duration of the call; callers must not
+// concatenate two lists onto each other concurrently from different goroutines.
+func (l *LinkedList[T]) Concat(other *LinkedList[T]) {
+	if other == nil || other == l {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	other.mutex.Lock()
+	defer other.mutex.Unlock()
+
+	if other.head == nil {
+		return
+	}
+	if l.tail == nil {
+		l.head = other.head
+	} else {
+		l.tail.Next = other.head
+		other.head.Prev = l.tail
+	}
+	l.tail = other.tail
+	l.size += other.size
+
+	other.head = nil
+	other.tail = nil
+	other.size = 0
+}
+
+// Clone returns an independent deep copy of the list.
+// Every node is newly allocated, so modifying the clone does not affect the original.
+// This operation has O(n) time complexity and is thread-safe using read locking.
+func (l *LinkedList[T]) Clone() *LinkedList[T] {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	clone := NewLinkedList[T]()
+	for current := l.head; current != nil; current = current.Next {
+		clone.appendNode(NewNode(current.Value))
+	}
+	return clone
+}
+
+// RemoveDuplicates removes every later occurrence of a value that already appeared
+// earlier in the list, keeping the first occurrence of each value.
+// It records seen values in a map, giving O(n) time and O(n) extra space.
+// This method is thread-safe using exclusive locking.
+func (l *LinkedList[T]) RemoveDuplicates() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	seen := make(map[T]struct{}, l.size)
+	current := l.head
+	for current != nil {
+		next := current.Next
+		if _, ok := seen[current.Value]; ok {
+			l.unlink(current)
+		} else {
+			seen[current.Value] = struct{}{}
+		}
+		current = next
+	}
+}
+
+// RemoveDuplicatesNoBuffer removes every later occurrence of a value that already appeared
+// earlier in the list without using an auxiliary buffer.
+// For each node a runner scans the rest of the list and unlinks equal values,
+// giving O(n^2) time and O(1) extra space.
+// This method is thread-safe using exclusive locking.
+func (l *LinkedList[T]) RemoveDuplicatesNoBuffer() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for current := l.head; current != nil; current = current.Next {
+		runner := current.Next
+		for runner != nil {
+			next := runner.Next
+			if runner.Value == current.Value {
+				l.unlink(runner)
+			}
+			runner = next
+		}
+	}
+}
+
+// appendNode links an unattached node after the current tail without locking.
+// This method assumes the caller has exclusive access to the list.
+func (l *LinkedList[T]) appendNode(node *Node[T]) {
+	if l.tail == nil {
+		l.head = node
+	} else {
+		node.Prev = l.tail
+		l.tail.Next = node
+	}
+	l.tail = node
+	l.size++
+}
@@ -1,6 +1,8 @@
 package linked_list
 
 import (
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 
@@ -130,6 +132,99 @@ func TestLinkedList_Prepend(t *testing.T) {
 	}
 }
 
+func TestLinkedList_Append(t *testing.T) {
+	tests := []struct {
+		name           string
+		initialValues  []int
+		appendValue    int
+		expectedValues []int
+	}{
+		{
+			name:           "append to empty list",
+			initialValues:  []int{},
+			appendValue:    1,
+			expectedValues: []int{1},
+		},
+		{
+			name:           "append to single element list",
+			initialValues:  []int{1},
+			appendValue:    2,
+			expectedValues: []int{1, 2},
+		},
+		{
+			name:           "append to multiple element list",
+			initialValues:  []int{1, 2, 3},
+			appendValue:    4,
+			expectedValues: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+
+			// Setup initial list
+			for _, v := range tt.initialValues {
+				list.Append(v)
+			}
+
+			// Append the test value
+			list.Append(tt.appendValue)
+
+			// Verify the result
+			values := collectValues(list)
+			assert.Equal(t, tt.expectedValues, values)
+			assert.Equal(t, tt.expectedValues[0], list.Head().Value)
+			assert.Equal(t, tt.appendValue, list.Tail().Value)
+			assert.Nil(t, list.Tail().Next)
+			if len(tt.expectedValues) > 1 {
+				assert.Equal(t, list.Tail(), list.Tail().Prev.Next)
+			}
+		})
+	}
+
+	t.Run("append and prepend", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		list.Append(2)
+		list.Prepend(1)
+		list.Append(3)
+		assert.Equal(t, []int{1, 2, 3}, collectValues(list))
+	})
+}
+
+func TestLinkedList_Len(t *testing.T) {
+	list := NewLinkedList[int]()
+	assert.Equal(t, 0, list.Len())
+
+	list.Prepend(2)
+	list.Append(4)
+	list.Prepend(1)
+	require.NoError(t, list.Insert(3, list.Search(2)))
+	list.Append(5)
+	assert.Equal(t, 5, list.Len())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, collectValues(list))
+
+	tests := []struct {
+		name        string
+		deleteValue int
+		expectedLen int
+	}{
+		{"delete head", 1, 4},
+		{"delete middle", 3, 3},
+		{"delete tail", 5, 2},
+		{"delete missing", 42, 2},
+		{"delete remaining head", 2, 1},
+		{"delete last node", 4, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = list.Delete(tt.deleteValue)
+			assert.Equal(t, tt.expectedLen, list.Len())
+			assert.Len(t, collectValues(list), tt.expectedLen)
+		})
+	}
+}
+
 func TestLinkedList_Search(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -361,6 +456,423 @@ func TestLinkedList_Delete(t *testing.T) {
 	}
 }
 
+func TestLinkedList_Reverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{"reverse empty list", []int{}, []int{}},
+		{"reverse single element", []int{1}, []int{1}},
+		{"reverse two elements", []int{1, 2}, []int{2, 1}},
+		{"reverse multiple elements", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, v := range tt.values {
+				list.Append(v)
+			}
+
+			list.Reverse()
+
+			assert.Equal(t, tt.expected, collectValues(list))
+			assert.Equal(t, len(tt.values), list.Len())
+			if len(tt.expected) == 0 {
+				assert.Nil(t, list.Head())
+				assert.Nil(t, list.Tail())
+				return
+			}
+			assert.Nil(t, list.Head().Prev)
+			assert.Nil(t, list.Tail().Next)
+			assert.Equal(t, tt.expected[len(tt.expected)-1], list.Tail().Value)
+
+			// Verify bidirectional links remain consistent
+			for node := list.Head(); node.Next != nil; node = node.Next {
+				assert.Equal(t, node, node.Next.Prev)
+			}
+
+			// Reversing twice restores the original order
+			list.Reverse()
+			assert.Equal(t, tt.values, collectValues(list))
+		})
+	}
+}
+
+func TestLinkedList_Get(t *testing.T) {
+	list := NewLinkedList[string]()
+	values := []string{"a", "b", "c", "d", "e"}
+	for _, v := range values {
+		list.Append(v)
+	}
+
+	for i, v := range values {
+		node, err := list.Get(i)
+		require.NoError(t, err)
+		require.NotNil(t, node)
+		assert.Equal(t, v, node.Value)
+		assert.Equal(t, getNodeAtIndex(list, i), node)
+	}
+
+	for _, index := range []int{-1, len(values), 100} {
+		node, err := list.Get(index)
+		assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+		assert.Nil(t, node)
+	}
+
+	_, err := NewLinkedList[int]().Get(0)
+	assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+}
+
+func TestLinkedList_ToSlice(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+	}{
+		{"empty list", []int{}},
+		{"single element", []int{1}},
+		{"multiple elements", []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, v := range tt.values {
+				list.Append(v)
+			}
+			slice := list.ToSlice()
+			assert.Equal(t, tt.values, slice)
+			assert.Equal(t, collectValues(list), slice)
+		})
+	}
+}
+
+func TestLinkedList_All(t *testing.T) {
+	list := NewLinkedList[int]()
+	assert.Empty(t, slices.Collect(list.All()))
+
+	for _, v := range []int{1, 2, 3} {
+		list.Append(v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(list.All()))
+
+	// The loop body may modify the list; iteration sees the values at the start.
+	var seen []int
+	for v := range list.All() {
+		seen = append(seen, v)
+		list.Append(v * 10)
+	}
+	assert.Equal(t, []int{1, 2, 3}, seen)
+	assert.Equal(t, 6, list.Len())
+}
+
+func TestLinkedList_InsertBefore(t *testing.T) {
+	tests := []struct {
+		name           string
+		initialValues  []int
+		beforeIndex    int
+		insertValue    int
+		expectedValues []int
+	}{
+		{"insert before single head", []int{2}, 0, 1, []int{1, 2}},
+		{"insert before head", []int{2, 3}, 0, 1, []int{1, 2, 3}},
+		{"insert before middle", []int{1, 3, 4}, 1, 2, []int{1, 2, 3, 4}},
+		{"insert before tail", []int{1, 2, 4}, 2, 3, []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, v := range tt.initialValues {
+				list.Append(v)
+			}
+
+			err := list.InsertBefore(tt.insertValue, getNodeAtIndex(list, tt.beforeIndex))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedValues, collectValues(list))
+			assert.Equal(t, len(tt.expectedValues), list.Len())
+			assert.Equal(t, tt.expectedValues[0], list.Head().Value)
+			assert.Nil(t, list.Head().Prev)
+			for node := list.Head(); node.Next != nil; node = node.Next {
+				assert.Equal(t, node, node.Next.Prev)
+			}
+		})
+	}
+
+	t.Run("insert before nil node", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		assert.ErrorIs(t, list.InsertBefore(1, nil), ErrorNodeIsNil)
+		assert.Equal(t, 0, list.Len())
+	})
+}
+
+func TestLinkedList_DeleteNode(t *testing.T) {
+	tests := []struct {
+		name           string
+		initialValues  []int
+		deleteIndex    int
+		expectedValues []int
+	}{
+		{"delete only node", []int{1}, 0, []int{}},
+		{"delete head", []int{1, 2, 3}, 0, []int{2, 3}},
+		{"delete middle", []int{1, 2, 3}, 1, []int{1, 3}},
+		{"delete tail", []int{1, 2, 3}, 2, []int{1, 2}},
+		{"delete duplicate by reference", []int{7, 7, 7}, 1, []int{7, 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, v := range tt.initialValues {
+				list.Append(v)
+			}
+			node := getNodeAtIndex(list, tt.deleteIndex)
+
+			require.NoError(t, list.DeleteNode(node))
+
+			assert.Equal(t, tt.expectedValues, collectValues(list))
+			assert.Equal(t, len(tt.expectedValues), list.Len())
+			assert.Nil(t, node.Prev)
+			assert.Nil(t, node.Next)
+			if len(tt.expectedValues) == 0 {
+				assert.Nil(t, list.Head())
+				assert.Nil(t, list.Tail())
+			}
+
+			// Deleting the same node again is detected
+			assert.ErrorIs(t, list.DeleteNode(node), ErrorNodeNotFound)
+			assert.Equal(t, len(tt.expectedValues), list.Len())
+		})
+	}
+
+	t.Run("delete nil node", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		assert.ErrorIs(t, list.DeleteNode(nil), ErrorNodeIsNil)
+	})
+}
+
+func TestLinkedList_HasCycle(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []int
+		loopTo     int // index the tail's Next points back to, -1 for no cycle
+		expectLoop bool
+	}{
+		{"empty list", []int{}, -1, false},
+		{"single element", []int{1}, -1, false},
+		{"acyclic list", []int{1, 2, 3, 4, 5}, -1, false},
+		{"self loop", []int{1}, 0, true},
+		{"tail loops to head", []int{1, 2, 3, 4}, 0, true},
+		{"tail loops to middle", []int{1, 2, 3, 4, 5}, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, v := range tt.values {
+				list.Append(v)
+			}
+			if tt.loopTo >= 0 {
+				list.Tail().Next = getNodeAtIndex(list, tt.loopTo)
+			}
+			assert.Equal(t, tt.expectLoop, list.HasCycle())
+		})
+	}
+}
+
+func TestLinkedList_ForEach(t *testing.T) {
+	list := NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3, 4} {
+		list.Append(v)
+	}
+
+	var visited []int
+	list.ForEach(func(v int) {
+		visited = append(visited, v)
+	})
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+
+	calls := 0
+	NewLinkedList[int]().ForEach(func(int) { calls++ })
+	assert.Zero(t, calls)
+}
+
+func TestLinkedList_Filter(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{"empty list", []int{}, []int{}},
+		{"no matches", []int{1, 3, 5}, []int{}},
+		{"some matches", []int{1, 2, 3, 4, 5, 6}, []int{2, 4, 6}},
+		{"all matches", []int{2, 4}, []int{2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, v := range tt.values {
+				list.Append(v)
+			}
+
+			filtered := list.Filter(func(v int) bool { return v%2 == 0 })
+
+			assert.Equal(t, tt.expected, collectValues(filtered))
+			assert.Equal(t, len(tt.expected), filtered.Len())
+			assert.Equal(t, tt.values, collectValues(list), "receiver must not be mutated")
+			if len(tt.expected) > 0 {
+				assert.Equal(t, tt.expected[len(tt.expected)-1], filtered.Tail().Value)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	list := NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		list.Append(v)
+	}
+
+	mapped := Map(list, func(v int) string {
+		return strings.Repeat("x", v)
+	})
+
+	assert.Equal(t, []string{"x", "xx", "xxx"}, collectValues(mapped))
+	assert.Equal(t, 3, mapped.Len())
+	assert.Equal(t, "xxx", mapped.Tail().Value)
+	assert.Equal(t, mapped.Tail(), mapped.Tail().Prev.Next)
+	assert.Equal(t, []int{1, 2, 3}, collectValues(list), "source must not be mutated")
+
+	empty := Map(NewLinkedList[int](), func(v int) int { return v })
+	assert.Nil(t, empty.Head())
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestLinkedList_Concat(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  []int
+		right []int
+	}{
+		{"both empty", []int{}, []int{}},
+		{"empty receiver", []int{}, []int{1, 2}},
+		{"empty other", []int{1, 2}, []int{}},
+		{"both non-empty", []int{1, 2, 3}, []int{4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, v := range tt.left {
+				list.Append(v)
+			}
+			other := NewLinkedList[int]()
+			for _, v := range tt.right {
+				other.Append(v)
+			}
+
+			list.Concat(other)
+
+			expected := append(append([]int{}, tt.left...), tt.right...)
+			assert.Equal(t, expected, collectValues(list))
+			assert.Equal(t, len(expected), list.Len())
+			assert.Nil(t, other.Head())
+			assert.Nil(t, other.Tail())
+			assert.Equal(t, 0, other.Len())
+
+			if len(expected) > 0 {
+				assert.Equal(t, expected[len(expected)-1], list.Tail().Value)
+				assert.Nil(t, list.Tail().Next)
+			}
+			// All links, including the join point, must be consistent
+			for node := list.Head(); node != nil && node.Next != nil; node = node.Next {
+				assert.Equal(t, node, node.Next.Prev)
+			}
+		})
+	}
+
+	t.Run("concat with itself is a no-op", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		list.Append(1)
+		list.Concat(list)
+		list.Concat(nil)
+		assert.Equal(t, []int{1}, collectValues(list))
+		assert.False(t, list.HasCycle())
+	})
+}
+
+func TestLinkedList_Clone(t *testing.T) {
+	list := NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		list.Append(v)
+	}
+
+	clone := list.Clone()
+	assert.Equal(t, []int{1, 2, 3}, collectValues(clone))
+	assert.Equal(t, 3, clone.Len())
+	assert.NotSame(t, list.Head(), clone.Head())
+
+	clone.Append(4)
+	require.NoError(t, clone.Delete(1))
+	clone.Head().Value = 20
+
+	assert.Equal(t, []int{1, 2, 3}, collectValues(list))
+	assert.Equal(t, 3, list.Len())
+	assert.Equal(t, []int{20, 3, 4}, collectValues(clone))
+
+	empty := NewLinkedList[int]().Clone()
+	assert.Nil(t, empty.Head())
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestLinkedList_RemoveDuplicates(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{"empty list", []int{}, []int{}},
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"all duplicates", []int{7, 7, 7, 7}, []int{7}},
+		{"duplicate head and tail", []int{1, 2, 3, 1}, []int{1, 2, 3}},
+		{"interleaved duplicates", []int{1, 2, 1, 3, 2, 4, 3}, []int{1, 2, 3, 4}},
+	}
+
+	methods := map[string]func(list *LinkedList[int]){
+		"RemoveDuplicates":         (*LinkedList[int]).RemoveDuplicates,
+		"RemoveDuplicatesNoBuffer": (*LinkedList[int]).RemoveDuplicatesNoBuffer,
+	}
+
+	for methodName, remove := range methods {
+		for _, tt := range tests {
+			t.Run(methodName+"/"+tt.name, func(t *testing.T) {
+				list := NewLinkedList[int]()
+				for _, v := range tt.values {
+					list.Append(v)
+				}
+
+				remove(list)
+
+				assert.Equal(t, tt.expected, collectValues(list))
+				assert.Equal(t, len(tt.expected), list.Len())
+				if len(tt.expected) == 0 {
+					assert.Nil(t, list.Head())
+					assert.Nil(t, list.Tail())
+					return
+				}
+				assert.Equal(t, tt.expected[0], list.Head().Value)
+				assert.Equal(t, tt.expected[len(tt.expected)-1], list.Tail().Value)
+				for node := list.Head(); node.Next != nil; node = node.Next {
+					assert.Equal(t, node, node.Next.Prev)
+				}
+			})
+		}
+	}
+}
+
 // Helper functions
 
 // collectValues returns all values in the list from head to tail
@@ -552,8 +1064,10 @@ func TestLinkedList_MixedConcurrentOperations(t *testing.T) {
 	// Final integrity check
 	values := collectValues(list)
 	assert.GreaterOrEqual(t, len(values), 0, "List should be accessible after mixed operations")
+	assert.Equal(t, len(values), list.Len(), "Len should match the number of reachable nodes")
 
 	// Verify list structure integrity
+	require.False(t, list.HasCycle(), "List should not contain a cycle")
 	current := list.Head()
 	count := 0
 	for current != nil {
@@ -562,9 +1076,6 @@ func TestLinkedList_MixedConcurrentOperations(t *testing.T) {
 			assert.Equal(t, current, current.Next.Prev, "Forward/backward links should be consistent")
 		}
 		current = current.Next
-
-		// Prevent infinite loops in case of corruption
-		assert.Less(t, count, 1000, "List traversal should not exceed reasonable bounds")
 	}
 	assert.Equal(t, len(values), count, "Collected values count should match traversal count")
 }
@@ -0,0 +1,116 @@
+package priorityqueue
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/cmputil"
+	"github.com/haru-256/ctci-6th-edition/pkg/heap"
+)
+
+// ErrRejected is returned when a full bounded priority queue refuses an item
+// whose priority is not higher than the lowest priority it currently holds.
+var ErrRejected = fmt.Errorf("item rejected: priority too low")
+
+// BoundedPriorityQueue keeps at most a fixed number of items, retaining the ones with
+// the highest priority. It is meant for "top N" streaming such as leaderboards:
+// once full, each new item either replaces the current lowest-priority item or is rejected.
+//
+// Internally it wraps a heap ordered by the inverse of PriorityCmp, so the item that
+// would be evicted next (lowest priority, latest insertion among equal priorities)
+// sits at the root and can be inspected and replaced in O(log n).
+//
+// Thread Safety:
+// The BoundedPriorityQueue is thread-safe for concurrent use by multiple goroutines.
+// Insert acquires an exclusive lock; Items and Size acquire a shared lock.
+//
+// Time complexities:
+//   - Insert: O(log n)
+//   - Items: O(n log n)
+//   - Size/Capacity: O(1)
+//
+// Space complexity: O(capacity).
+type BoundedPriorityQueue[T comparable] struct {
+	heap     *heap.Heap[Task[T]]
+	capacity int
+	mu       sync.RWMutex
+}
+
+// NewBoundedPriorityQueue creates an empty priority queue that holds at most capacity items.
+// The capacity must be greater than 0, otherwise the function will panic.
+//
+// Example:
+//
+//	top3 := NewBoundedPriorityQueue[string](3)
+//	for player, score := range scores {
+//		_ = top3.Insert(player, score)
+//	}
+//	leaders := top3.Items() // highest score first
+func NewBoundedPriorityQueue[T comparable](capacity int) *BoundedPriorityQueue[T] {
+	if capacity <= 0 {
+		panic("bounded priority queue capacity must be greater than 0")
+	}
+	return &BoundedPriorityQueue[T]{
+		heap:     heap.NewHeap(cmputil.Reverse(PriorityCmp[T])),
+		capacity: capacity,
+	}
+}
+
+// Insert adds an item with the specified priority.
+//
+// While the queue has room the item is always added. Once it is full, the item
+// replaces the current lowest-priority item if its priority is strictly higher;
+// otherwise the queue is left unchanged and ErrRejected is returned.
+//
+// Thread Safety: This method is thread-safe. It acquires an exclusive lock during
+// the entire operation so the comparison and the eviction happen atomically.
+//
+// Time complexity: O(log n)
+func (pq *BoundedPriorityQueue[T]) Insert(item T, priority int) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if pq.heap.Size() < pq.capacity {
+		return pq.heap.Insert(NewTask(priority, item))
+	}
+
+	lowest, err := pq.heap.Peek()
+	if err != nil {
+		return err
+	}
+	if priority <= lowest.Priority {
+		return ErrRejected
+	}
+	if _, err := pq.heap.Pop(); err != nil {
+		return err
+	}
+	return pq.heap.Insert(NewTask(priority, item))
+}
+
+// Items returns the tasks currently held, ordered from highest to lowest precedence
+// as defined by PriorityCmp. The returned slice is a new slice, but its tasks are the
+// ones stored in the queue and should not be modified.
+//
+// Time complexity: O(n log n)
+func (pq *BoundedPriorityQueue[T]) Items() []*Task[T] {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+
+	items := pq.heap.GetItems()
+	slices.SortFunc(items, cmputil.Reverse(PriorityCmp[T]))
+	return items
+}
+
+// Size returns the number of items currently held.
+func (pq *BoundedPriorityQueue[T]) Size() int {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+
+	return pq.heap.Size()
+}
+
+// Capacity returns the maximum number of items the queue can hold.
+func (pq *BoundedPriorityQueue[T]) Capacity() int {
+	return pq.capacity
+}
@@ -0,0 +1,67 @@
+package priorityqueue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper function to extract values and priorities from bounded queue items
+func boundedContents(pq *BoundedPriorityQueue[string]) []testItem {
+	var contents []testItem
+	for _, task := range pq.Items() {
+		contents = append(contents, testItem{value: task.Value, priority: task.Priority})
+	}
+	return contents
+}
+
+func TestNewBoundedPriorityQueue(t *testing.T) {
+	pq := NewBoundedPriorityQueue[string](3)
+	require.NotNil(t, pq)
+	assert.Equal(t, 0, pq.Size())
+	assert.Equal(t, 3, pq.Capacity())
+	assert.Empty(t, pq.Items())
+
+	assert.Panics(t, func() { NewBoundedPriorityQueue[string](0) })
+}
+
+func TestBoundedPriorityQueue_Insert(t *testing.T) {
+	pq := NewBoundedPriorityQueue[string](3)
+
+	// Fills up without rejecting anything
+	require.NoError(t, pq.Insert("alice", 50))
+	require.NoError(t, pq.Insert("bob", 20))
+	require.NoError(t, pq.Insert("carol", 70))
+	assert.Equal(t, 3, pq.Size())
+
+	// Lower and equal priorities are rejected once full
+	assert.ErrorIs(t, pq.Insert("dave", 10), ErrRejected)
+	assert.ErrorIs(t, pq.Insert("erin", 20), ErrRejected)
+	assert.Equal(t, []testItem{{"carol", 70}, {"alice", 50}, {"bob", 20}}, boundedContents(pq))
+
+	// A higher priority evicts the current lowest
+	require.NoError(t, pq.Insert("frank", 60))
+	assert.Equal(t, []testItem{{"carol", 70}, {"frank", 60}, {"alice", 50}}, boundedContents(pq))
+
+	require.NoError(t, pq.Insert("grace", 100))
+	assert.Equal(t, []testItem{{"grace", 100}, {"carol", 70}, {"frank", 60}}, boundedContents(pq))
+	assert.Equal(t, 3, pq.Size())
+}
+
+func TestBoundedPriorityQueue_TopN(t *testing.T) {
+	pq := NewBoundedPriorityQueue[int](5)
+	for i := 0; i < 1000; i++ {
+		priority := (i * 7919) % 1000 // every value in [0, 1000) exactly once
+		err := pq.Insert(i, priority)
+		if err != nil {
+			require.ErrorIs(t, err, ErrRejected)
+		}
+	}
+
+	var priorities []int
+	for _, task := range pq.Items() {
+		priorities = append(priorities, task.Priority)
+	}
+	assert.Equal(t, []int{999, 998, 997, 996, 995}, priorities)
+}
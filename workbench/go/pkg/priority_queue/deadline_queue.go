@@ -0,0 +1,98 @@
+package priorityqueue
+
+import (
+	"time"
+)
+
+// DeadlineQueue is a min-priority queue for timer-style scheduling. Each task's Priority
+// holds its deadline as Unix nanoseconds, so the task that is due soonest is at the front.
+//
+// Tasks with the same deadline are served in insertion order: the deadline is compared
+// first, and Task.Time (set when the task is inserted) only breaks ties between equal
+// deadlines. Task.Time is never compared against the deadline itself.
+//
+// Thread Safety:
+// The DeadlineQueue is thread-safe for concurrent use by multiple goroutines.
+// It delegates to a PriorityQueue, whose operations acquire exclusive locks.
+//
+// Time complexities:
+//   - Insert: O(log n)
+//   - Pop: O(log n)
+//   - PopExpired: O(k log n) where k is the number of expired tasks
+//
+// Space complexity: O(n) where n is the number of tasks in the queue.
+type DeadlineQueue[T comparable] struct {
+	pq *PriorityQueue[T]
+}
+
+// NewDeadlineQueue creates an empty deadline queue.
+//
+// Example:
+//
+//	timers := NewDeadlineQueue[string]()
+//	timers.Insert("retry", time.Now().Add(2*time.Second))
+//	for _, task := range timers.PopExpired(time.Now()) {
+//		fmt.Println("due:", task.Value)
+//	}
+func NewDeadlineQueue[T comparable]() *DeadlineQueue[T] {
+	return &DeadlineQueue[T]{
+		pq: NewPriorityQueue(DeadlineCmp[T]),
+	}
+}
+
+// Insert adds an item that becomes due at deadline.
+// The deadline is stored in the task's Priority as Unix nanoseconds.
+//
+// Time complexity: O(log n)
+func (dq *DeadlineQueue[T]) Insert(item T, deadline time.Time) error {
+	return dq.pq.Insert(item, int(deadline.UnixNano()))
+}
+
+// Pop removes and returns the task with the earliest deadline, whether or not it has expired.
+// Returns heap.ErrorIsEmpty if the queue is empty.
+//
+// Time complexity: O(log n)
+func (dq *DeadlineQueue[T]) Pop() (*Task[T], error) {
+	return dq.pq.Pop()
+}
+
+// PopExpired removes and returns every task whose deadline is at or before now,
+// earliest deadline first. Tasks that are not yet due stay in the queue.
+//
+// Time complexity: O(k log n) where k is the number of expired tasks
+func (dq *DeadlineQueue[T]) PopExpired(now time.Time) []*Task[T] {
+	limit := int(now.UnixNano())
+	return dq.pq.PopWhile(func(task *Task[T]) bool {
+		return task.Priority <= limit
+	})
+}
+
+// Size returns the number of tasks in the queue.
+func (dq *DeadlineQueue[T]) Size() int {
+	dq.pq.mu.RLock()
+	defer dq.pq.mu.RUnlock()
+
+	return dq.pq.heap.Size()
+}
+
+// DeadlineCmp is a comparison function for tasks that implements min-heap behavior,
+// for use when Priority holds a deadline.
+//
+// Comparison logic:
+//  1. Lower priority numbers (earlier deadlines) come first
+//  2. For equal priorities, earlier creation times come first
+//  3. Tasks with identical priority and time are considered equal
+func DeadlineCmp[T comparable](a, b *Task[T]) int {
+	if a.Priority > b.Priority {
+		return -1
+	} else if a.Priority < b.Priority {
+		return 1
+	}
+	// If deadlines are equal, the task inserted first wins
+	if a.Time.Before(b.Time) {
+		return 1
+	} else if a.Time.After(b.Time) {
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,73 @@
+package priorityqueue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/heap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadlineQueue_PopExpired(t *testing.T) {
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	dq := NewDeadlineQueue[string]()
+	require.NoError(t, dq.Insert("in-3s", base.Add(3*time.Second)))
+	require.NoError(t, dq.Insert("in-1s", base.Add(1*time.Second)))
+	require.NoError(t, dq.Insert("past", base.Add(-time.Minute)))
+	require.NoError(t, dq.Insert("in-2s", base.Add(2*time.Second)))
+	assert.Equal(t, 4, dq.Size())
+
+	values := func(tasks []*Task[string]) []string {
+		result := make([]string, len(tasks))
+		for i, task := range tasks {
+			result[i] = task.Value
+		}
+		return result
+	}
+
+	assert.Empty(t, dq.PopExpired(base.Add(-2*time.Minute)))
+	assert.Equal(t, []string{"past"}, values(dq.PopExpired(base)))
+	// A deadline equal to now counts as expired
+	assert.Equal(t, []string{"in-1s", "in-2s"}, values(dq.PopExpired(base.Add(2*time.Second))))
+	assert.Equal(t, 1, dq.Size())
+
+	task, err := dq.Pop()
+	require.NoError(t, err)
+	assert.Equal(t, "in-3s", task.Value)
+	assert.Equal(t, int(base.Add(3*time.Second).UnixNano()), task.Priority)
+
+	_, err = dq.Pop()
+	assert.ErrorIs(t, err, heap.ErrorIsEmpty)
+	assert.Empty(t, dq.PopExpired(base.Add(time.Hour)))
+}
+
+func TestDeadlineQueue_TieBreaking(t *testing.T) {
+	deadline := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	dq := NewDeadlineQueue[string]()
+	for _, name := range []string{"first", "second", "third"} {
+		require.NoError(t, dq.Insert(name, deadline))
+		time.Sleep(time.Millisecond) // distinct insertion times
+	}
+
+	expired := dq.PopExpired(deadline)
+	require.Len(t, expired, 3)
+	assert.Equal(t, "first", expired[0].Value)
+	assert.Equal(t, "second", expired[1].Value)
+	assert.Equal(t, "third", expired[2].Value)
+}
+
+func TestDeadlineCmp(t *testing.T) {
+	now := time.Now()
+	early := &Task[string]{Priority: 10, Time: now, Value: "early"}
+	late := &Task[string]{Priority: 20, Time: now, Value: "late"}
+	earlyOlder := &Task[string]{Priority: 10, Time: now.Add(-time.Second), Value: "early-older"}
+
+	assert.Equal(t, 1, DeadlineCmp(early, late))
+	assert.Equal(t, -1, DeadlineCmp(late, early))
+	assert.Equal(t, 1, DeadlineCmp(earlyOlder, early))
+	assert.Equal(t, -1, DeadlineCmp(early, earlyOlder))
+	assert.Equal(t, 0, DeadlineCmp(early, early))
+}
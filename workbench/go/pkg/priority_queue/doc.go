@@ -18,7 +18,7 @@ their insertion time, with earlier items having higher precedence.
 
 This implementation is thread-safe and can be used concurrently by multiple goroutines.
 All public methods use appropriate mutex locking:
-- All operations (Insert, Pop, Update) use RWMutex.Lock() for exclusive access
+- All operations (Insert, Pop, PopWhile, Update) use RWMutex.Lock() for exclusive access
 - The priority queue safely coordinates with the underlying heap's thread-safe operations
 - Update operations acquire exclusive locks during both search and heap rebalancing phases
 
@@ -28,6 +28,7 @@ No external synchronization is required when using this priority queue from mult
 
 - Insert: O(log n)
 - Pop: O(log n)
+- PopWhile: O(k log n) for k removed tasks
 - Update: O(n) for search + O(log n) for rebalancing
 - Space: O(n)
 
@@ -71,6 +72,27 @@ No external synchronization is required when using this priority queue from mult
 		fmt.Printf("Job not found: %v\n", err)
 	}
 
+# Batch Pop
+
+PopWhile drains tasks from the front while a predicate holds, which suits a scheduler
+tick that handles every ready task at once. The first task that fails the predicate is
+left in the queue.
+
+	ready := pq.PopWhile(func(t *priorityqueue.Task[string]) bool {
+		return t.Priority >= 5
+	})
+	for _, task := range ready { // highest priority first
+		fmt.Println(task.Value)
+	}
+
+DrainFunc pops every remaining task in priority order and hands each one to a callback,
+holding the lock throughout so producers cannot interleave new tasks. This replaces a
+caller-side loop that races with producers, for example on shutdown:
+
+	_ = pq.DrainFunc(func(t *priorityqueue.Task[string]) {
+		fmt.Println("finishing", t.Value)
+	})
+
 # Concurrent Usage
 
 The priority queue is thread-safe and can be used safely from multiple goroutines
@@ -119,11 +141,68 @@ For different ordering behaviors, you can provide custom comparison functions:
 
 	minPQ := priorityqueue.NewPriorityQueue[string](minHeapCmp)
 
+# Bounded Priority Queue
+
+BoundedPriorityQueue keeps only the N highest-priority items, which suits "top N"
+streaming such as leaderboards. Once full, a new item evicts the lowest-priority item
+if its priority is higher, and is rejected with ErrRejected otherwise.
+
+	top3 := priorityqueue.NewBoundedPriorityQueue[string](3)
+	for player, score := range scores {
+		_ = top3.Insert(player, score)
+	}
+	for _, task := range top3.Items() { // highest priority first
+		fmt.Printf("%s: %d\n", task.Value, task.Priority)
+	}
+
+# Deadline Queue
+
+DeadlineQueue turns the priority queue into a simple timer queue. Priority holds each
+task's deadline as Unix nanoseconds and the earliest deadline is served first.
+PopExpired drains every task that is due. Task.Time is still the insertion time and
+only breaks ties between tasks with the same deadline, so those run in insertion order.
+
+	timers := priorityqueue.NewDeadlineQueue[string]()
+	timers.Insert("flush", time.Now().Add(100*time.Millisecond))
+	timers.Insert("heartbeat", time.Now().Add(time.Second))
+
+	for _, task := range timers.PopExpired(time.Now()) {
+		fmt.Println("due:", task.Value)
+	}
+
+# Frequency Queue
+
+FrequencyQueue derives each item's priority from how many times it has been added.
+It is an indexed heap, so Add finds the item and moves it up in O(log n), and TopK
+returns the most frequent items without consuming the queue:
+
+	fq := priorityqueue.NewFrequencyQueue[string]()
+	for _, word := range strings.Fields(text) {
+		fq.Add(word)
+	}
+	top10 := fq.TopK(10) // most frequent first; ties by first appearance
+
+# Checkpointing
+
+PriorityQueue implements gob.GobEncoder and gob.GobDecoder. Each task is saved with its
+value, priority and timestamp, so a restored queue pops tasks in the same order. The
+comparison function cannot be encoded; to restore a custom order, decode into a queue
+created with NewPriorityQueue:
+
+	var buf bytes.Buffer
+	_ = gob.NewEncoder(&buf).Encode(pq)
+	restored := priorityqueue.NewPriorityQueue[string](priorityqueue.PriorityCmp[string])
+	_ = gob.NewDecoder(&buf).Decode(restored)
+
+A zero PriorityQueue, such as the one gob allocates for a *PriorityQueue field of a larger
+value, is restored with PriorityCmp, the default highest-priority-first order.
+
 # Error Handling
 
 The package defines specific errors for different failure conditions:
 
 - ErrNotFound: Returned when trying to update an item that doesn't exist in the queue
+- ErrRejected: Returned when a full BoundedPriorityQueue refuses a low-priority item
 - heap.ErrorIsEmpty: Returned when trying to pop from an empty queue
 
 Always check for errors when calling Pop() and Update() methods.
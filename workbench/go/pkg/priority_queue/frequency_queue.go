@@ -0,0 +1,151 @@
+package priorityqueue
+
+import (
+	"sync"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/heap"
+)
+
+// frequencyEntry is a distinct item tracked by a FrequencyQueue.
+type frequencyEntry[T comparable] struct {
+	value T
+	count int
+	seq   int // order in which the item was first added, for tie-breaking
+	pos   int // current index in FrequencyQueue.entries
+}
+
+// FrequencyQueue orders items by how many times they have been added, most frequent
+// first, which suits "top K frequent words" style analytics.
+// Items with equal counts are ordered by when they were first added, earliest first.
+//
+// Internally it is an indexed max heap: each item remembers its position in the heap
+// array, so Add finds it through a map in O(1) and sifts it up in O(log n), instead of
+// the linear scan that PriorityQueue.Update needs.
+//
+// Thread Safety:
+// The FrequencyQueue is thread-safe for concurrent use by multiple goroutines.
+// Add acquires an exclusive lock; TopK, Count and Len acquire a shared lock.
+//
+// Time complexities:
+//   - Add: O(log n)
+//   - TopK: O(n + k log n)
+//   - Count/Len: O(1)
+//
+// Space complexity: O(n) where n is the number of distinct items.
+type FrequencyQueue[T comparable] struct {
+	entries []*frequencyEntry[T]
+	index   map[T]*frequencyEntry[T]
+	nextSeq int
+	mu      sync.RWMutex
+}
+
+// NewFrequencyQueue creates an empty frequency queue.
+//
+// Example:
+//
+//	fq := NewFrequencyQueue[string]()
+//	for _, word := range strings.Fields(text) {
+//		fq.Add(word)
+//	}
+//	top := fq.TopK(10)
+func NewFrequencyQueue[T comparable]() *FrequencyQueue[T] {
+	return &FrequencyQueue[T]{
+		entries: []*frequencyEntry[T]{},
+		index:   make(map[T]*frequencyEntry[T]),
+	}
+}
+
+// Add increments the count of item, adding it with a count of 1 if it is new,
+// and moves it towards the front of the queue as needed.
+//
+// Time complexity: O(log n)
+func (fq *FrequencyQueue[T]) Add(item T) {
+	fq.mu.Lock()
+	defer fq.mu.Unlock()
+
+	entry, exists := fq.index[item]
+	if !exists {
+		entry = &frequencyEntry[T]{value: item, seq: fq.nextSeq, pos: len(fq.entries)}
+		fq.nextSeq++
+		fq.entries = append(fq.entries, entry)
+		fq.index[item] = entry
+	}
+	entry.count++
+	// A count only ever grows, so the entry can only need to move up.
+	fq.siftUp(entry.pos)
+}
+
+// TopK returns up to k items with the highest counts, most frequent first.
+// If k <= 0 it returns an empty slice; if k exceeds the number of distinct items,
+// every item is returned. The queue itself is not modified.
+//
+// Time complexity: O(n + k log n)
+func (fq *FrequencyQueue[T]) TopK(k int) []T {
+	fq.mu.RLock()
+	defer fq.mu.RUnlock()
+
+	k = min(k, len(fq.entries))
+	if k <= 0 {
+		return []T{}
+	}
+
+	// Pop from a heap over a copy of the entry pointers so the queue keeps its layout.
+	// The copy is already in heap order, so wrapping it costs a single O(n) pass.
+	working := make([]*frequencyEntry[T], len(fq.entries))
+	copy(working, fq.entries)
+	h := heap.NewHeapWrapping(working, frequencyCmp[T])
+
+	top := make([]T, 0, k)
+	for range k {
+		entry, err := h.Pop()
+		if err != nil {
+			break
+		}
+		top = append(top, entry.value)
+	}
+	return top
+}
+
+// Count returns how many times item has been added, or 0 if it has never been added.
+func (fq *FrequencyQueue[T]) Count(item T) int {
+	fq.mu.RLock()
+	defer fq.mu.RUnlock()
+
+	if entry, exists := fq.index[item]; exists {
+		return entry.count
+	}
+	return 0
+}
+
+// Len returns the number of distinct items in the queue.
+func (fq *FrequencyQueue[T]) Len() int {
+	fq.mu.RLock()
+	defer fq.mu.RUnlock()
+
+	return len(fq.entries)
+}
+
+// siftUp moves the entry at index i up until its parent ranks ahead of it,
+// keeping every entry's pos in sync with its index.
+// This method assumes the caller already holds the write lock.
+func (fq *FrequencyQueue[T]) siftUp(i int) {
+	for i > 0 {
+		parent := heap.Parent(i)
+		if frequencyCmp(fq.entries[parent], fq.entries[i]) >= 0 {
+			return
+		}
+		fq.entries[parent], fq.entries[i] = fq.entries[i], fq.entries[parent]
+		fq.entries[parent].pos = parent
+		fq.entries[i].pos = i
+		i = parent
+	}
+}
+
+// frequencyCmp returns a positive value if a ranks ahead of b: a higher count wins,
+// and among equal counts the item added first wins.
+func frequencyCmp[T comparable](a, b *frequencyEntry[T]) int {
+	if a.count != b.count {
+		return a.count - b.count
+	}
+	return b.seq - a.seq
+}
@@ -0,0 +1,89 @@
+package priorityqueue
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestFrequencyQueue_TopK(t *testing.T) {
+	fq := NewFrequencyQueue[string]()
+	for _, word := range strings.Fields("the cat and the dog and the bird saw a cat") {
+		fq.Add(word)
+	}
+
+	assert.Equal(t, 7, fq.Len())
+	assert.Equal(t, 3, fq.Count("the"))
+	assert.Equal(t, 2, fq.Count("cat"))
+	assert.Equal(t, 0, fq.Count("fish"))
+
+	tests := []struct {
+		name string
+		k    int
+		want []string
+	}{
+		{"top one", 1, []string{"the"}},
+		// "cat" and "and" tie at 2; "cat" was seen first
+		{"ties by first appearance", 3, []string{"the", "cat", "and"}},
+		{"k larger than len", 100, []string{"the", "cat", "and", "dog", "bird", "saw", "a"}},
+		{"zero", 0, []string{}},
+		{"negative", -1, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fq.TopK(tt.k))
+		})
+	}
+
+	// TopK does not consume the queue
+	assert.Equal(t, []string{"the", "cat", "and"}, fq.TopK(3))
+}
+
+func TestFrequencyQueue_Reorders(t *testing.T) {
+	fq := NewFrequencyQueue[int]()
+	fq.Add(1)
+	fq.Add(2)
+	fq.Add(3)
+	assert.Equal(t, []int{1, 2, 3}, fq.TopK(3))
+
+	fq.Add(3)
+	fq.Add(3)
+	fq.Add(2)
+	assert.Equal(t, []int{3, 2, 1}, fq.TopK(3))
+
+	// Every entry's position matches its index in the heap array
+	for i, entry := range fq.entries {
+		require.Equal(t, i, entry.pos)
+	}
+}
+
+func TestFrequencyQueue_Empty(t *testing.T) {
+	fq := NewFrequencyQueue[string]()
+	assert.Empty(t, fq.TopK(5))
+	assert.Equal(t, 0, fq.Len())
+}
+
+func TestFrequencyQueue_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	fq := NewFrequencyQueue[int]()
+	var g errgroup.Group
+	for i := 0; i < 8; i++ {
+		g.Go(func() error {
+			for v := 0; v < 10; v++ {
+				for range v + 1 { // value v is added v+1 times per goroutine
+					fq.Add(v)
+				}
+				_ = fq.TopK(3)
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait())
+
+	assert.Equal(t, []int{9, 8, 7}, fq.TopK(3))
+	assert.Equal(t, 80, fq.Count(9))
+}
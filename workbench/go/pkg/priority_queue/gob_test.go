@@ -0,0 +1,92 @@
+package priorityqueue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityQueue_Gob(t *testing.T) {
+	t.Run("round trip preserves priority order and timestamps", func(t *testing.T) {
+		pq := NewPriorityQueue[string](PriorityCmp[string])
+		require.NoError(t, pq.Insert("low", 1))
+		require.NoError(t, pq.Insert("high", 10))
+		require.NoError(t, pq.Insert("mid-1", 5))
+		time.Sleep(time.Millisecond) // keep the tie-break distinguishable by wall-clock time
+		require.NoError(t, pq.Insert("mid-2", 5))
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(pq))
+
+		restored := NewPriorityQueue[string](PriorityCmp[string])
+		require.NoError(t, restored.Insert("stale", 100))
+		require.NoError(t, gob.NewDecoder(&buf).Decode(restored))
+		assert.Equal(t, pq.heap.Size(), restored.heap.Size(), "decoding replaces existing tasks")
+
+		for pq.heap.Size() > 0 {
+			want, err := pq.Pop()
+			require.NoError(t, err)
+			got, err := restored.Pop()
+			require.NoError(t, err)
+			assert.Equal(t, want.Value, got.Value)
+			assert.Equal(t, want.Priority, got.Priority)
+			assert.True(t, want.Time.Equal(got.Time))
+		}
+		assert.Equal(t, 0, restored.heap.Size())
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(NewPriorityQueue[int](PriorityCmp[int])))
+		restored := NewPriorityQueue[int](PriorityCmp[int])
+		require.NoError(t, gob.NewDecoder(&buf).Decode(restored))
+		assert.Equal(t, 0, restored.heap.Size())
+	})
+
+	t.Run("field of a larger value", func(t *testing.T) {
+		type checkpoint struct {
+			Name  string
+			Queue *PriorityQueue[string]
+		}
+		pq := NewPriorityQueue[string](PriorityCmp[string])
+		require.NoError(t, pq.Insert("low", 1))
+		require.NoError(t, pq.Insert("high", 10))
+		require.NoError(t, pq.Insert("mid", 5))
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(checkpoint{Name: "jobs", Queue: pq}))
+
+		// gob allocates a zero PriorityQueue for the field, which decodes with PriorityCmp.
+		var restored checkpoint
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&restored))
+		assert.Equal(t, "jobs", restored.Name)
+		require.NotNil(t, restored.Queue)
+		for _, want := range []string{"high", "mid", "low"} {
+			task, err := restored.Queue.Pop()
+			require.NoError(t, err)
+			assert.Equal(t, want, task.Value)
+		}
+	})
+
+	t.Run("zero queue", func(t *testing.T) {
+		var zero PriorityQueue[int]
+		data, err := zero.GobEncode()
+		require.NoError(t, err)
+
+		var restored PriorityQueue[int]
+		require.NoError(t, restored.GobDecode(data))
+		assert.Equal(t, 0, restored.heap.Size())
+		require.NoError(t, restored.Insert(1, 1), "a decoded zero queue is ready to use")
+	})
+
+	t.Run("malformed data", func(t *testing.T) {
+		restored := NewPriorityQueue[int](PriorityCmp[int])
+		require.NoError(t, restored.Insert(1, 1))
+		assert.Error(t, restored.GobDecode([]byte("not gob")))
+		assert.Equal(t, 1, restored.heap.Size(), "a failed decode leaves the queue unchanged")
+	})
+}
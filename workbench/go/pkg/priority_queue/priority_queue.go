@@ -106,6 +106,61 @@ func (pq *PriorityQueue[T]) Pop() (*Task[T], error) {
 	return task, nil
 }
 
+// PopWhile removes and returns tasks from the front of the queue for as long as pred
+// holds for the current front task.
+//
+// The tasks are returned in the order they would have been popped. The first task for
+// which pred returns false stays in the queue. An empty queue, or a front task that fails
+// pred, yields an empty slice.
+//
+// Thread Safety: This method is thread-safe. It holds an exclusive lock for the whole
+// drain, so no other goroutine can insert between the peek and the pop. pred must not
+// call back into the queue.
+//
+// Time complexity: O(k log n) where k is the number of tasks removed
+//
+// Example:
+//
+//	// Drain every task with priority 5 or higher in one call
+//	ready := pq.PopWhile(func(t *Task[string]) bool { return t.Priority >= 5 })
+func (pq *PriorityQueue[T]) PopWhile(pred func(*Task[T]) bool) []*Task[T] {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	tasks := []*Task[T]{}
+	for {
+		task, err := pq.heap.Peek()
+		if err != nil || !pred(task) {
+			return tasks
+		}
+		if _, err := pq.heap.Pop(); err != nil {
+			return tasks
+		}
+		tasks = append(tasks, task)
+	}
+}
+
+// DrainFunc pops every task in priority order and calls fn with each one until the
+// queue is empty, e.g. to process the remaining work exactly once on shutdown.
+//
+// Thread Safety: This method is thread-safe. It holds an exclusive lock for the whole
+// drain, so tasks inserted by producers in the meantime are never interleaved; they
+// wait until the drain has finished. fn must not call back into the queue.
+//
+// Time complexity: O(n log n)
+//
+// Example:
+//
+//	err := pq.DrainFunc(func(t *Task[string]) {
+//		process(t.Value)
+//	})
+func (pq *PriorityQueue[T]) DrainFunc(fn func(*Task[T])) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.heap.DrainFunc(fn)
+}
+
 // Update changes the priority of an existing item in the queue.
 //
 // Searches for the item with the given value and updates its priority.
@@ -115,7 +170,7 @@ func (pq *PriorityQueue[T]) Pop() (*Task[T], error) {
 //
 // Thread Safety: This method is thread-safe. It acquires an exclusive lock during
 // the entire operation to ensure atomic search, priority update, and heap rebalancing.
-// The underlying heap's UpHeap/DownHeap methods are called safely within the lock.
+// The underlying heap's UpdateAt method is called safely within the lock.
 //
 // Time complexity: O(n) for searching + O(log n) for rebalancing
 //
@@ -146,23 +201,10 @@ func (pq *PriorityQueue[T]) Update(item T, priority int) error {
 		return nil
 	}
 
-	toLargerThan := targetTask.Priority < priority
-	toLessThan := targetTask.Priority > priority
-
-	targetTask.Priority = priority
-
-	if toLessThan {
-		// Priority decreased (was more, now lower), move down towards leaves
-		if err := pq.heap.DownHeap(targetIdx); err != nil {
-			return err
-		}
-	} else if toLargerThan {
-		// Priority increased (was less, now higher), move up towards root
-		if err := pq.heap.UpHeap(targetIdx); err != nil {
-			return err
-		}
-	}
-	return nil
+	// UpdateAt moves the task up or down depending on how its priority changed
+	return pq.heap.UpdateAt(targetIdx, func(task *Task[T]) {
+		task.Priority = priority
+	})
 }
 
 // Task represents an item in the priority queue with associated metadata.
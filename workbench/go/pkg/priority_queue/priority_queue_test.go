@@ -157,6 +157,63 @@ func TestPriorityQueue_Pop(t *testing.T) {
 	}
 }
 
+func TestPriorityQueue_PopWhile(t *testing.T) {
+	items := []testItem{
+		{"low", 1},
+		{"urgent", 9},
+		{"normal", 5},
+		{"high", 7},
+		{"minor", 3},
+	}
+
+	tests := []struct {
+		name      string
+		threshold int
+		want      []testItem
+		remaining int
+	}{
+		{
+			name:      "drain tasks at or above threshold",
+			threshold: 5,
+			want:      []testItem{{"urgent", 9}, {"high", 7}, {"normal", 5}},
+			remaining: 2,
+		},
+		{
+			name:      "front below threshold",
+			threshold: 10,
+			want:      []testItem{},
+			remaining: 5,
+		},
+		{
+			name:      "drain everything",
+			threshold: 0,
+			want:      []testItem{{"urgent", 9}, {"high", 7}, {"normal", 5}, {"minor", 3}, {"low", 1}},
+			remaining: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq := setupPriorityQueue(items)
+
+			tasks := pq.PopWhile(func(task *Task[string]) bool { return task.Priority >= tt.threshold })
+
+			got := make([]testItem, len(tasks))
+			for i, task := range tasks {
+				got[i] = testItem{task.Value, task.Priority}
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.remaining, pq.heap.Size())
+		})
+	}
+
+	t.Run("empty queue", func(t *testing.T) {
+		pq := NewPriorityQueue(PriorityCmp[string])
+		tasks := pq.PopWhile(func(*Task[string]) bool { return true })
+		assert.Empty(t, tasks)
+	})
+}
+
 func TestPriorityQueue_Update(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -896,3 +953,19 @@ func verifyDescendingOrder(t *testing.T, priorities []int) {
 			i, priorities[i-1], priorities[i])
 	}
 }
+
+func TestPriorityQueue_DrainFunc(t *testing.T) {
+	pq := NewPriorityQueue[string](PriorityCmp[string])
+	require.NoError(t, pq.Insert("low", 1))
+	require.NoError(t, pq.Insert("high", 10))
+	require.NoError(t, pq.Insert("mid", 5))
+
+	var drained []string
+	require.NoError(t, pq.DrainFunc(func(task *Task[string]) {
+		drained = append(drained, task.Value)
+	}))
+	assert.Equal(t, []string{"high", "mid", "low"}, drained)
+
+	_, err := pq.Pop()
+	assert.Error(t, err, "the queue is empty after draining")
+}
@@ -0,0 +1,89 @@
+package queue
+
+import (
+	"context"
+)
+
+// BlockingQueue is a bounded FIFO queue for producer/consumer pipelines that applies
+// backpressure instead of returning overflow and underflow errors: Enqueue waits for
+// free space and Dequeue waits for an item. It is backed by a buffered channel and is
+// safe for use by multiple goroutines.
+// The zero value is not ready to use; use NewBlockingQueue to create a new queue.
+//
+// Time complexity:
+//   - Enqueue/Dequeue: O(1), plus any time spent waiting
+//   - Size/Count: O(1)
+//
+// Space complexity: O(n) where n is the capacity.
+type BlockingQueue[T any] struct {
+	items chan T
+}
+
+// NewBlockingQueue creates and returns a new BlockingQueue with the specified capacity.
+// The size parameter must be greater than 0, otherwise the function will panic.
+//
+// Example:
+//
+//	q := NewBlockingQueue[int](10)
+//	go func() {
+//	    for i := 0; i < 100; i++ {
+//	        q.Enqueue(i) // waits while the consumer is behind
+//	    }
+//	}()
+//	for i := 0; i < 100; i++ {
+//	    fmt.Println(q.Dequeue())
+//	}
+func NewBlockingQueue[T any](size int) *BlockingQueue[T] {
+	if size <= 0 {
+		panic("queue size must be greater than 0")
+	}
+	return &BlockingQueue[T]{
+		items: make(chan T, size),
+	}
+}
+
+// Enqueue adds an item to the rear of the queue, blocking until space is available.
+func (q *BlockingQueue[T]) Enqueue(item T) {
+	q.items <- item
+}
+
+// Dequeue removes and returns the item at the front of the queue, blocking until
+// an item is available.
+func (q *BlockingQueue[T]) Dequeue() T {
+	return <-q.items
+}
+
+// EnqueueCtx adds an item to the rear of the queue, blocking until space is available
+// or ctx is done. If ctx is done first, the item is not added and ctx.Err() is returned.
+func (q *BlockingQueue[T]) EnqueueCtx(ctx context.Context, item T) error {
+	select {
+	case q.items <- item:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DequeueCtx removes and returns the item at the front of the queue, blocking until
+// an item is available or ctx is done. If ctx is done first, it returns the zero value
+// and ctx.Err().
+func (q *BlockingQueue[T]) DequeueCtx(ctx context.Context) (T, error) {
+	select {
+	case item := <-q.items:
+		return item, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Size returns the maximum capacity of the queue.
+func (q *BlockingQueue[T]) Size() int {
+	return cap(q.items)
+}
+
+// Count returns the current number of items in the queue.
+// Other goroutines may change the count as soon as it is read.
+func (q *BlockingQueue[T]) Count() int {
+	return len(q.items)
+}
@@ -0,0 +1,113 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+	q := NewBlockingQueue[int](3)
+	assert.Equal(t, 3, q.Size())
+	assert.Equal(t, 0, q.Count())
+
+	assert.Panics(t, func() { NewBlockingQueue[int](0) })
+}
+
+func TestBlockingQueue_FIFO(t *testing.T) {
+	q := NewBlockingQueue[string](3)
+	q.Enqueue("a")
+	q.Enqueue("b")
+	q.Enqueue("c")
+	assert.Equal(t, 3, q.Count())
+
+	assert.Equal(t, "a", q.Dequeue())
+	assert.Equal(t, "b", q.Dequeue())
+	assert.Equal(t, "c", q.Dequeue())
+	assert.Equal(t, 0, q.Count())
+}
+
+func TestBlockingQueue_Ctx(t *testing.T) {
+	t.Run("dequeue on empty queue times out", func(t *testing.T) {
+		q := NewBlockingQueue[int](1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		val, err := q.DequeueCtx(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 0, val)
+	})
+
+	t.Run("enqueue on full queue is cancelled", func(t *testing.T) {
+		q := NewBlockingQueue[int](1)
+		require.NoError(t, q.EnqueueCtx(context.Background(), 1))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, q.EnqueueCtx(ctx, 2), context.Canceled)
+		assert.Equal(t, 1, q.Count(), "cancelled item should not be added")
+	})
+
+	t.Run("succeeds when ready", func(t *testing.T) {
+		q := NewBlockingQueue[int](1)
+		ctx := context.Background()
+		require.NoError(t, q.EnqueueCtx(ctx, 42))
+		val, err := q.DequeueCtx(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 42, val)
+	})
+}
+
+func TestBlockingQueue_Backpressure(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	q.Enqueue(1)
+
+	enqueued := make(chan struct{})
+	go func() {
+		q.Enqueue(2) // blocks until the first item is dequeued
+		close(enqueued)
+	}()
+
+	select {
+	case <-enqueued:
+		t.Fatal("Enqueue should block while the queue is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	assert.Equal(t, 1, q.Dequeue())
+	<-enqueued
+	assert.Equal(t, 2, q.Dequeue())
+}
+
+func TestBlockingQueue_ProducerConsumer(t *testing.T) {
+	t.Parallel()
+
+	const numProducers = 4
+	const itemsPerProducer = 250
+
+	q := NewBlockingQueue[int](8)
+	var g errgroup.Group
+	for p := 0; p < numProducers; p++ {
+		p := p
+		g.Go(func() error {
+			for j := 0; j < itemsPerProducer; j++ {
+				q.Enqueue(p*itemsPerProducer + j)
+			}
+			return nil
+		})
+	}
+
+	seen := make(map[int]bool)
+	for i := 0; i < numProducers*itemsPerProducer; i++ {
+		val := q.Dequeue()
+		require.False(t, seen[val], "item %d dequeued twice", val)
+		seen[val] = true
+	}
+	require.NoError(t, g.Wait())
+	assert.Len(t, seen, numProducers*itemsPerProducer)
+	assert.Equal(t, 0, q.Count())
+}
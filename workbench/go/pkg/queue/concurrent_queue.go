@@ -0,0 +1,134 @@
+package queue
+
+import (
+	"sync"
+)
+
+// ConcurrentQueue is a fixed-capacity circular queue that is safe for use by multiple
+// goroutines, such as the producers and consumers of a bounded pipeline.
+// It wraps a Queue behind a single sync.Mutex, so every operation, including a check
+// followed by an action inside Do, runs as one critical section.
+// The zero value is not ready to use; use NewConcurrentQueue to create a new queue.
+//
+// Enqueue and Dequeue return the same ErrorQueueOverflow and ErrorQueueUnderflow errors as Queue.
+//
+// Time complexity:
+//   - Enqueue: O(1)
+//   - Dequeue: O(1)
+//   - Peek: O(1)
+//   - IsEmpty/IsFull/Size/Count: O(1)
+//   - ToSlice/Clone: O(n)
+//
+// Space complexity: O(n) where n is the capacity.
+type ConcurrentQueue[T any] struct {
+	queue *Queue[T]
+	mu    sync.Mutex
+}
+
+// NewConcurrentQueue creates and returns a new ConcurrentQueue with the specified capacity.
+// The size parameter must be greater than 0, otherwise the function will panic.
+//
+// Example:
+//
+//	q := NewConcurrentQueue[string](100)
+//	go func() { _ = q.Enqueue("job") }()
+func NewConcurrentQueue[T any](size int) *ConcurrentQueue[T] {
+	return &ConcurrentQueue[T]{
+		queue: NewQueue[T](size),
+	}
+}
+
+// Enqueue adds an item to the rear of the queue.
+// Returns ErrorQueueOverflow if the queue is full.
+func (q *ConcurrentQueue[T]) Enqueue(item T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Enqueue(item)
+}
+
+// Dequeue removes and returns the item at the front of the queue.
+// Returns ErrorQueueUnderflow if the queue is empty.
+func (q *ConcurrentQueue[T]) Dequeue() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Dequeue()
+}
+
+// Peek returns the item at the front of the queue without removing it.
+// Returns ErrorQueueUnderflow if the queue is empty.
+func (q *ConcurrentQueue[T]) Peek() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Peek()
+}
+
+// IsEmpty returns true if the queue has no items.
+func (q *ConcurrentQueue[T]) IsEmpty() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.IsEmpty()
+}
+
+// IsFull returns true if the queue has reached its capacity.
+func (q *ConcurrentQueue[T]) IsFull() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.IsFull()
+}
+
+// Size returns the maximum capacity of the queue.
+func (q *ConcurrentQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Size()
+}
+
+// Count returns the current number of items in the queue.
+func (q *ConcurrentQueue[T]) Count() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Count()
+}
+
+// ToSlice returns a copy of the queue's items in FIFO order, front first.
+func (q *ConcurrentQueue[T]) ToSlice() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.ToSlice()
+}
+
+// Clear removes all items from the queue, keeping its capacity.
+func (q *ConcurrentQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Clear()
+}
+
+// Clone returns an independent ConcurrentQueue holding the same items in the same order.
+func (q *ConcurrentQueue[T]) Clone() *ConcurrentQueue[T] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return &ConcurrentQueue[T]{
+		queue: q.queue.Clone(),
+	}
+}
+
+// Do calls fn with the underlying queue while holding the lock, so a sequence such as
+// "dequeue only if the front matches" happens atomically. fn must not retain the queue
+// or call back into q.
+func (q *ConcurrentQueue[T]) Do(fn func(queue *Queue[T])) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	fn(q.queue)
+}
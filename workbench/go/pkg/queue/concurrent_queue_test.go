@@ -0,0 +1,110 @@
+package queue
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestConcurrentQueue_Operations(t *testing.T) {
+	q := NewConcurrentQueue[int](2)
+	assert.True(t, q.IsEmpty())
+	assert.Equal(t, 2, q.Size())
+
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, ErrorQueueUnderflow)
+	_, err = q.Peek()
+	assert.ErrorIs(t, err, ErrorQueueUnderflow)
+
+	require.NoError(t, q.Enqueue(1))
+	require.NoError(t, q.Enqueue(2))
+	assert.True(t, q.IsFull())
+	assert.ErrorIs(t, q.Enqueue(3), ErrorQueueOverflow)
+	assert.Equal(t, []int{1, 2}, q.ToSlice())
+
+	clone := q.Clone()
+	front, err := q.Dequeue()
+	require.NoError(t, err)
+	assert.Equal(t, 1, front)
+	assert.Equal(t, 1, q.Count())
+	assert.Equal(t, []int{1, 2}, clone.ToSlice(), "clone should be independent")
+
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+
+	assert.Panics(t, func() { NewConcurrentQueue[int](0) })
+}
+
+func TestConcurrentQueue_ProducerConsumer(t *testing.T) {
+	t.Parallel()
+
+	const numProducers = 4
+	const numConsumers = 4
+	const itemsPerProducer = 250
+	const total = numProducers * itemsPerProducer
+
+	q := NewConcurrentQueue[int](16)
+	var consumed [total]atomic.Int32
+	var remaining atomic.Int32
+	remaining.Store(total)
+
+	var g errgroup.Group
+	for p := 0; p < numProducers; p++ {
+		p := p
+		g.Go(func() error {
+			for j := 0; j < itemsPerProducer; {
+				err := q.Enqueue(p*itemsPerProducer + j)
+				if err == ErrorQueueOverflow {
+					runtime.Gosched() // full; retry until a consumer makes room
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				j++
+			}
+			return nil
+		})
+	}
+	for c := 0; c < numConsumers; c++ {
+		g.Go(func() error {
+			for remaining.Load() > 0 {
+				val, err := q.Dequeue()
+				if err == ErrorQueueUnderflow {
+					runtime.Gosched() // empty; wait for a producer
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				consumed[val].Add(1)
+				remaining.Add(-1)
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait(), "producers and consumers should not fail")
+
+	assert.True(t, q.IsEmpty())
+	for val := range consumed {
+		require.Equal(t, int32(1), consumed[val].Load(), "item %d consumed wrong number of times", val)
+	}
+}
+
+func TestConcurrentQueue_Do(t *testing.T) {
+	q := NewConcurrentQueue[int](4)
+	require.NoError(t, q.Enqueue(7))
+
+	var front int
+	q.Do(func(inner *Queue[int]) {
+		if v, err := inner.Peek(); err == nil && v == 7 {
+			front, _ = inner.Dequeue()
+		}
+	})
+	assert.Equal(t, 7, front)
+	assert.True(t, q.IsEmpty())
+}
@@ -0,0 +1,179 @@
+package queue
+
+import "sync"
+
+// Deque represents a generic double-ended queue backed by a fixed-size circular buffer.
+// Items can be added and removed at both the front and the back.
+// The zero value is not ready to use; use NewDeque to create a new deque.
+//
+// The deque has a fixed capacity determined at creation time and will return
+// ErrorQueueOverflow when attempting to push beyond capacity, or ErrorQueueUnderflow
+// when attempting to pop from an empty deque.
+//
+// head is the index of the front item and tail is the index one past the back item;
+// both wrap around the buffer, so no operation ever shifts elements.
+//
+// Time complexity:
+//   - PushFront/PushBack: O(1)
+//   - PopFront/PopBack: O(1)
+//   - PeekFront/PeekBack: O(1)
+//   - IsEmpty/IsFull/Size/Count: O(1)
+//
+// Space complexity: O(n) where n is the capacity.
+type Deque[T any] struct {
+	items []T
+	size  int // maximum number of items the deque can hold
+	count int // current number of items in the deque
+	head  int // index of the front item
+	tail  int // index one past the back item
+	mu    sync.RWMutex
+}
+
+// NewDeque creates and returns a new Deque with the specified capacity.
+// The size parameter must be greater than 0, otherwise the function will panic.
+//
+// Example:
+//
+//	deque := NewDeque[int](10)
+//	_ = deque.PushBack(1)
+//	_ = deque.PushFront(0)
+//	front, _ := deque.PopFront() // front = 0
+//	back, _ := deque.PopBack()   // back = 1
+func NewDeque[T any](size int) *Deque[T] {
+	if size <= 0 {
+		panic("deque size must be greater than 0")
+	}
+	return &Deque[T]{
+		items: make([]T, size),
+		size:  size,
+	}
+}
+
+// IsEmpty checks if the deque is empty.
+// Returns true if there are no elements in the deque.
+func (d *Deque[T]) IsEmpty() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.count == 0
+}
+
+// IsFull checks if the deque is full.
+// Returns true if the deque has reached its maximum capacity.
+func (d *Deque[T]) IsFull() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.count == d.size
+}
+
+// PushFront adds an item to the front of the deque.
+// Returns ErrorQueueOverflow if the deque is full.
+func (d *Deque[T]) PushFront(item T) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.count == d.size {
+		return ErrorQueueOverflow
+	}
+
+	d.head = (d.head - 1 + d.size) % d.size
+	d.items[d.head] = item
+	d.count++
+	return nil
+}
+
+// PushBack adds an item to the back of the deque.
+// Returns ErrorQueueOverflow if the deque is full.
+func (d *Deque[T]) PushBack(item T) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.count == d.size {
+		return ErrorQueueOverflow
+	}
+
+	d.items[d.tail] = item
+	d.tail = (d.tail + 1) % d.size
+	d.count++
+	return nil
+}
+
+// PopFront removes and returns the front item of the deque.
+// Returns ErrorQueueUnderflow if the deque is empty.
+func (d *Deque[T]) PopFront() (T, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var zero T
+	if d.count == 0 {
+		return zero, ErrorQueueUnderflow
+	}
+
+	item := d.items[d.head]
+	d.items[d.head] = zero // Clear the slot
+	d.head = (d.head + 1) % d.size
+	d.count--
+	return item, nil
+}
+
+// PopBack removes and returns the back item of the deque.
+// Returns ErrorQueueUnderflow if the deque is empty.
+func (d *Deque[T]) PopBack() (T, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var zero T
+	if d.count == 0 {
+		return zero, ErrorQueueUnderflow
+	}
+
+	d.tail = (d.tail - 1 + d.size) % d.size
+	item := d.items[d.tail]
+	d.items[d.tail] = zero // Clear the slot
+	d.count--
+	return item, nil
+}
+
+// PeekFront returns the front item of the deque without removing it.
+// Returns ErrorQueueUnderflow if the deque is empty.
+func (d *Deque[T]) PeekFront() (T, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.count == 0 {
+		var zero T
+		return zero, ErrorQueueUnderflow
+	}
+	return d.items[d.head], nil
+}
+
+// PeekBack returns the back item of the deque without removing it.
+// Returns ErrorQueueUnderflow if the deque is empty.
+func (d *Deque[T]) PeekBack() (T, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.count == 0 {
+		var zero T
+		return zero, ErrorQueueUnderflow
+	}
+	return d.items[(d.tail-1+d.size)%d.size], nil
+}
+
+// Size returns the maximum capacity of the deque.
+func (d *Deque[T]) Size() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.size
+}
+
+// Count returns the current number of items in the deque.
+// This value ranges from 0 (empty) to Size() (full).
+func (d *Deque[T]) Count() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.count
+}
@@ -0,0 +1,129 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeque(t *testing.T) {
+	d := NewDeque[int](3)
+	require.NotNil(t, d)
+	assert.True(t, d.IsEmpty())
+	assert.False(t, d.IsFull())
+	assert.Equal(t, 3, d.Size())
+	assert.Equal(t, 0, d.Count())
+
+	assert.Panics(t, func() { NewDeque[int](0) })
+	assert.Panics(t, func() { NewDeque[int](-1) })
+}
+
+func TestDeque_EmptyAndFull(t *testing.T) {
+	d := NewDeque[int](2)
+
+	_, err := d.PopFront()
+	assert.Equal(t, ErrorQueueUnderflow, err)
+	_, err = d.PopBack()
+	assert.Equal(t, ErrorQueueUnderflow, err)
+	_, err = d.PeekFront()
+	assert.Equal(t, ErrorQueueUnderflow, err)
+	_, err = d.PeekBack()
+	assert.Equal(t, ErrorQueueUnderflow, err)
+
+	require.NoError(t, d.PushBack(1))
+	require.NoError(t, d.PushFront(0))
+	assert.True(t, d.IsFull())
+	assert.Equal(t, ErrorQueueOverflow, d.PushBack(2))
+	assert.Equal(t, ErrorQueueOverflow, d.PushFront(-1))
+	assert.Equal(t, 2, d.Count())
+}
+
+func TestDeque_BothEnds(t *testing.T) {
+	d := NewDeque[int](5)
+
+	// Build [1 2 3 4 5] from both ends
+	require.NoError(t, d.PushBack(3))
+	require.NoError(t, d.PushFront(2))
+	require.NoError(t, d.PushBack(4))
+	require.NoError(t, d.PushFront(1))
+	require.NoError(t, d.PushBack(5))
+
+	front, err := d.PeekFront()
+	require.NoError(t, err)
+	assert.Equal(t, 1, front)
+	back, err := d.PeekBack()
+	require.NoError(t, err)
+	assert.Equal(t, 5, back)
+
+	popped, err := d.PopBack()
+	require.NoError(t, err)
+	assert.Equal(t, 5, popped)
+	popped, err = d.PopFront()
+	require.NoError(t, err)
+	assert.Equal(t, 1, popped)
+	popped, err = d.PopBack()
+	require.NoError(t, err)
+	assert.Equal(t, 4, popped)
+	popped, err = d.PopFront()
+	require.NoError(t, err)
+	assert.Equal(t, 2, popped)
+	popped, err = d.PopFront()
+	require.NoError(t, err)
+	assert.Equal(t, 3, popped)
+	assert.True(t, d.IsEmpty())
+}
+
+func TestDeque_CircularBehavior(t *testing.T) {
+	t.Run("queue usage wraps at the back", func(t *testing.T) {
+		d := NewDeque[int](3)
+		require.NoError(t, d.PushBack(1))
+		require.NoError(t, d.PushBack(2))
+		require.NoError(t, d.PushBack(3))
+
+		dequeued, err := d.PopFront()
+		require.NoError(t, err)
+		assert.Equal(t, 1, dequeued)
+
+		// Tail wraps around to index 0
+		require.NoError(t, d.PushBack(4))
+		for _, want := range []int{2, 3, 4} {
+			got, err := d.PopFront()
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+		assert.True(t, d.IsEmpty())
+	})
+
+	t.Run("stack usage wraps at the front", func(t *testing.T) {
+		d := NewDeque[int](3)
+
+		// Head wraps below index 0 on the first PushFront
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, d.PushFront(i))
+		}
+		back, err := d.PeekBack()
+		require.NoError(t, err)
+		assert.Equal(t, 1, back)
+
+		for _, want := range []int{3, 2, 1} {
+			got, err := d.PopFront()
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("pop back across the wrap point", func(t *testing.T) {
+		d := NewDeque[int](3)
+		require.NoError(t, d.PushFront(2))
+		require.NoError(t, d.PushBack(3))
+		require.NoError(t, d.PushFront(1))
+
+		for _, want := range []int{3, 2, 1} {
+			got, err := d.PopBack()
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+		assert.True(t, d.IsEmpty())
+	})
+}
@@ -7,6 +7,7 @@
 // Key Features:
 //   - Generic implementation supporting any type T
 //   - Fixed-capacity circular buffer for O(1) operations
+//   - Optional dynamic queue that grows its buffer instead of overflowing
 //   - Thread-unsafe operations for maximum performance
 //   - Comprehensive error handling with custom error types
 //   - Zero-value safety with proper initialization checks
@@ -23,7 +24,20 @@
 //
 // Thread Safety:
 // This implementation is NOT thread-safe. If you need concurrent access,
-// you must provide your own synchronization mechanisms.
+// use ConcurrentQueue, which wraps a Queue behind a mutex and adds Do for
+// running a check-then-act sequence atomically:
+//
+//	cq := queue.NewConcurrentQueue[string](100)
+//	go func() { _ = cq.Enqueue("job") }()
+//	job, err := cq.Dequeue()
+//
+// For backpressure, use BlockingQueue instead. It is backed by a buffered channel:
+// Enqueue blocks while the queue is full and Dequeue blocks while it is empty, and
+// EnqueueCtx/DequeueCtx stop waiting and return ctx.Err() when the context is done.
+//
+//	bq := queue.NewBlockingQueue[string](100)
+//	go func() { bq.Enqueue("job") }()
+//	job, err := bq.DequeueCtx(ctx)
 //
 // Example usage:
 //
@@ -53,6 +67,74 @@
 //	fmt.Println("Count:", q.Count())   // 1 (current items)
 //	fmt.Println("Size:", q.Size())     // 10 (total capacity)
 //
+//	// Capacity and Len are aliases of Size and Count with less ambiguous names
+//	fmt.Println("Capacity:", q.Capacity())       // 10
+//	fmt.Println("Len:", q.Len())                 // 1
+//	fmt.Println("Utilization:", q.Utilization()) // 0.1
+//
+// Dynamic Queue:
+// NewDynamicQueue creates a queue without a fixed capacity. When the buffer is full,
+// Enqueue doubles it and copies the items across in FIFO order, so Enqueue never returns
+// ErrorQueueOverflow and runs in amortized O(1) time.
+//
+//	dq := queue.NewDynamicQueue[int]()
+//	for i := 0; i < 1000; i++ {
+//	    _ = dq.Enqueue(i)
+//	}
+//
+// A dynamic queue does not shrink on its own. After a burst has drained, ShrinkToFit
+// reallocates the buffer down to the current number of items to release the memory:
+//
+//	dq.ShrinkToFit()
+//
+// Batch Operations:
+// EnqueueAll and DequeueN move many items under a single lock. EnqueueAll accepts as many
+// items as fit and reports how many it took, returning ErrorQueueOverflow for the rest;
+// DequeueN returns up to n items in FIFO order, which makes chunked processing easy:
+//
+//	n, err := q.EnqueueAll(items)
+//	batch, err := q.DequeueN(100)
+//
+// Double-Ended Queue:
+// Deque stores items in the same kind of fixed-capacity circular buffer but allows
+// pushing and popping at both ends in O(1) time. It reports the same
+// ErrorQueueOverflow and ErrorQueueUnderflow errors as Queue.
+//
+//	d := queue.NewDeque[int](10)
+//	_ = d.PushBack(2)
+//	_ = d.PushFront(1)
+//	back, _ := d.PopBack()   // back = 2
+//	front, _ := d.PopFront() // front = 1
+//
+// Inspection:
+// For comparable item types, Contains reports whether a value is currently buffered,
+// scanning only the live items without dequeuing them:
+//
+//	if queue.Contains(q, 42) {
+//	    fmt.Println("42 is still waiting")
+//	}
+//
+// Equal compares the live items of two queues in FIFO order without consuming either,
+// which keeps test assertions free of drain-and-compare loops:
+//
+//	assert.True(t, queue.Equal(q, restored))
+//
+// All returns an iter.Seq over a snapshot of the items in FIFO order, for range-over-func
+// loops and the collections package:
+//
+//	for item := range q.All() {
+//	    fmt.Println(item)
+//	}
+//
+// Checkpointing:
+// Queue implements gob.GobEncoder and gob.GobDecoder, so it can be saved with encoding/gob
+// and restored into a new value with the same items, capacity and FIFO order:
+//
+//	var buf bytes.Buffer
+//	_ = gob.NewEncoder(&buf).Encode(q)
+//	var restored queue.Queue[int]
+//	_ = gob.NewDecoder(&buf).Decode(&restored)
+//
 // Error Handling:
 // The queue operations return specific errors for different failure conditions:
 //   - ErrorQueueOverflow: Returned when trying to enqueue to a full queue
@@ -0,0 +1,78 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+// encodedQueue is the gob wire format of a Queue.
+// Items are stored in FIFO order, the same order as ToSlice.
+type encodedQueue[T any] struct {
+	Items    []T
+	Capacity int
+	Dynamic  bool
+}
+
+// GobEncode implements gob.GobEncoder so that a Queue can be checkpointed with encoding/gob,
+// either on its own or as a field of a larger value.
+// It records the items in FIFO order, the capacity and whether the queue is dynamic;
+// the position of the items inside the circular buffer is not preserved.
+// T must itself be encodable by gob; interface types need to be registered with gob.Register.
+// This method is thread-safe and uses a read lock for concurrent access.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	err := gob.NewEncoder(&buf).Encode(q)
+func (q *Queue[T]) GobEncode() ([]byte, error) {
+	q.mu.RLock()
+	encoded := encodedQueue[T]{
+		Items:    make([]T, q.count),
+		Capacity: q.size,
+		Dynamic:  q.dynamic,
+	}
+	for i := range encoded.Items {
+		encoded.Items[i] = q.items[(q.head+i)%q.size]
+	}
+	q.mu.RUnlock()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(encoded); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder and replaces the contents of the queue with the
+// encoded items, so that dequeuing the decoded queue yields them in the original FIFO order.
+// The capacity and the dynamic flag are restored as well. The receiver may be a zero Queue.
+// It returns an error if the data is malformed or holds more items than its capacity.
+// This method is thread-safe and uses a write lock for concurrent access.
+//
+// Example:
+//
+//	var restored queue.Queue[int]
+//	err := gob.NewDecoder(&buf).Decode(&restored)
+func (q *Queue[T]) GobDecode(data []byte) error {
+	var encoded encodedQueue[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&encoded); err != nil {
+		return err
+	}
+	if encoded.Capacity <= 0 || len(encoded.Items) > encoded.Capacity {
+		return fmt.Errorf("queue: cannot decode %d items with capacity %d", len(encoded.Items), encoded.Capacity)
+	}
+
+	items := make([]T, encoded.Capacity)
+	copy(items, encoded.Items)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items = items
+	q.size = encoded.Capacity
+	q.count = len(encoded.Items)
+	q.head = 0
+	q.tail = q.count % q.size
+	q.dynamic = encoded.Dynamic
+	return nil
+}
@@ -0,0 +1,84 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue_Gob(t *testing.T) {
+	t.Run("round trip preserves FIFO order across wraparound", func(t *testing.T) {
+		q := NewQueue[int](4)
+		for i := 0; i < 4; i++ {
+			require.NoError(t, q.Enqueue(i))
+		}
+		// Move head and tail so the items wrap around the end of the buffer.
+		_, _ = q.Dequeue()
+		_, _ = q.Dequeue()
+		require.NoError(t, q.Enqueue(4))
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(q))
+
+		var restored Queue[int]
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&restored))
+		assert.Equal(t, 4, restored.Size())
+		assert.Equal(t, q.ToSlice(), restored.ToSlice())
+
+		// The restored queue must keep working after decoding.
+		require.NoError(t, restored.Enqueue(5))
+		require.NoError(t, q.Enqueue(5))
+		for !q.IsEmpty() {
+			want, err := q.Dequeue()
+			require.NoError(t, err)
+			got, err := restored.Dequeue()
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+		assert.True(t, restored.IsEmpty())
+	})
+
+	t.Run("full queue", func(t *testing.T) {
+		q := NewQueue[string](2)
+		require.NoError(t, q.Enqueue("a"))
+		require.NoError(t, q.Enqueue("b"))
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(q))
+		var restored Queue[string]
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&restored))
+		assert.True(t, restored.IsFull())
+		assert.ErrorIs(t, restored.Enqueue("c"), ErrorQueueOverflow)
+		assert.Equal(t, []string{"a", "b"}, restored.ToSlice())
+	})
+
+	t.Run("dynamic queue keeps growing", func(t *testing.T) {
+		q := NewDynamicQueue[int]()
+		for i := 0; i < 10; i++ {
+			require.NoError(t, q.Enqueue(i))
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(q))
+		var restored Queue[int]
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&restored))
+		for i := 10; i < 50; i++ {
+			require.NoError(t, restored.Enqueue(i))
+		}
+		assert.Equal(t, 50, restored.Count())
+		item, err := restored.Dequeue()
+		require.NoError(t, err)
+		assert.Equal(t, 0, item)
+	})
+
+	t.Run("inconsistent data", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(encodedQueue[int]{Items: []int{1, 2, 3}, Capacity: 2}))
+		var restored Queue[int]
+		assert.Error(t, restored.GobDecode(buf.Bytes()))
+		assert.Error(t, restored.GobDecode([]byte("not gob")))
+	})
+}
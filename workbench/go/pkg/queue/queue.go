@@ -2,6 +2,7 @@ package queue
 
 import (
 	"errors"
+	"iter"
 	"sync"
 )
 
@@ -12,6 +13,9 @@ var (
 	ErrorQueueUnderflow = errors.New("queue underflow")
 )
 
+// minDynamicCapacity is the initial capacity of a dynamic queue.
+const minDynamicCapacity = 4
+
 // Queue represents a generic FIFO (First In, First Out) circular queue using a fixed-size array.
 // It stores values of type T directly and provides thread-unsafe operations.
 // The zero value is not ready to use; use NewQueue to create a new queue.
@@ -36,7 +40,9 @@ type Queue[T any] struct {
 	count int // current number of items in the queue
 	head  int
 	tail  int
-	mu    sync.RWMutex
+	// dynamic reports whether the queue grows on Enqueue instead of overflowing
+	dynamic bool
+	mu      sync.RWMutex
 }
 
 // NewQueue creates and returns a new Queue with the specified capacity.
@@ -73,6 +79,29 @@ func NewQueue[T any](size int) *Queue[T] {
 	}
 }
 
+// NewDynamicQueue creates and returns a new, empty Queue without a fixed capacity.
+// When Enqueue finds the circular buffer full, the buffer is doubled and its items are
+// copied into the new buffer in FIFO order starting at index 0, so Enqueue never returns
+// ErrorQueueOverflow and IsFull always reports false. Size reports the current capacity
+// of the buffer.
+//
+// Enqueue runs in amortized O(1) time: a resize copies all items, but it happens only
+// after a number of operations proportional to the queue's size.
+//
+// Example:
+//
+//	queue := NewDynamicQueue[int]()
+//	for i := 0; i < 1000; i++ {
+//	    _ = queue.Enqueue(i) // never overflows
+//	}
+func NewDynamicQueue[T any]() *Queue[T] {
+	return &Queue[T]{
+		items:   make([]T, minDynamicCapacity),
+		size:    minDynamicCapacity,
+		dynamic: true,
+	}
+}
+
 // IsEmpty checks if the queue is empty.
 // Returns true if there are no elements in the queue.
 func (q *Queue[T]) IsEmpty() bool {
@@ -84,15 +113,17 @@ func (q *Queue[T]) IsEmpty() bool {
 
 // IsFull checks if the queue is full.
 // Returns true if the queue has reached its maximum capacity.
+// A dynamic queue is never full.
 func (q *Queue[T]) IsFull() bool {
 	q.mu.RLock()
 	defer q.mu.RUnlock()
 
-	return q.count == q.size
+	return !q.dynamic && q.count == q.size
 }
 
 // Enqueue adds an item to the rear of the queue.
 // Returns ErrorQueueOverflow if the queue is full.
+// A dynamic queue doubles its capacity instead of returning an error.
 // The queue follows FIFO order, so this item will be the last to be dequeued.
 //
 // Example:
@@ -109,7 +140,10 @@ func (q *Queue[T]) Enqueue(item T) error {
 	// We cannot call q.IsFull() here because it would cause a deadlock:
 	// IsFull() tries to acquire an RLock while we already hold a Lock.
 	if q.count == q.size {
-		return ErrorQueueOverflow
+		if !q.dynamic {
+			return ErrorQueueOverflow
+		}
+		q.resize(q.size * 2)
 	}
 
 	q.items[q.tail] = item
@@ -149,6 +183,75 @@ func (q *Queue[T]) Dequeue() (T, error) {
 	return item, nil
 }
 
+// EnqueueAll adds items to the rear of the queue in order, under a single lock.
+// It enqueues as many items as fit and returns how many were accepted; if some did not fit,
+// it also returns ErrorQueueOverflow and the remaining items are left out.
+// A dynamic queue grows at most once to hold all items and never overflows.
+//
+// Time complexity: O(k) where k is len(items).
+//
+// Example:
+//
+//	q := NewQueue[int](3)
+//	n, err := q.EnqueueAll([]int{1, 2, 3, 4}) // n == 3, err == ErrorQueueOverflow
+func (q *Queue[T]) EnqueueAll(items []T) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.dynamic && q.count+len(items) > q.size {
+		capacity := q.size
+		for capacity < q.count+len(items) {
+			capacity *= 2
+		}
+		q.resize(capacity)
+	}
+
+	accepted := min(len(items), q.size-q.count)
+	for _, item := range items[:accepted] {
+		q.items[q.tail] = item
+		q.tail = (q.tail + 1) % q.size
+	}
+	q.count += accepted
+	if accepted < len(items) {
+		return accepted, ErrorQueueOverflow
+	}
+	return accepted, nil
+}
+
+// DequeueN removes up to n items from the front of the queue, under a single lock,
+// and returns them in FIFO order. If the queue holds fewer than n items, all of them
+// are returned. It returns ErrorQueueUnderflow if n > 0 and the queue is empty;
+// if n <= 0, it returns an empty slice and no error.
+//
+// Time complexity: O(k) where k is the number of items returned.
+//
+// Example:
+//
+//	for batch, err := q.DequeueN(100); err == nil; batch, err = q.DequeueN(100) {
+//	    process(batch)
+//	}
+func (q *Queue[T]) DequeueN(n int) ([]T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if n <= 0 {
+		return []T{}, nil
+	}
+	if q.count == 0 {
+		return nil, ErrorQueueUnderflow
+	}
+
+	var zero T
+	items := make([]T, min(n, q.count))
+	for i := range items {
+		items[i] = q.items[q.head]
+		q.items[q.head] = zero // Clear the slot
+		q.head = (q.head + 1) % q.size
+	}
+	q.count -= len(items)
+	return items, nil
+}
+
 // Peek returns the front item from the queue without removing it.
 // Returns ErrorQueueUnderflow if the queue is empty.
 // This operation does not modify the queue.
@@ -176,8 +279,9 @@ func (q *Queue[T]) Peek() (T, error) {
 	return q.items[q.head], nil
 }
 
-// Size returns the maximum capacity of the queue.
-// This is the size that was specified when the queue was created.
+// Size returns the maximum capacity of the queue, not the number of items; see Count.
+// This is the size that was specified when the queue was created,
+// or the current capacity of the buffer for a dynamic queue.
 func (q *Queue[T]) Size() int {
 	q.mu.RLock()
 	defer q.mu.RUnlock()
@@ -193,3 +297,180 @@ func (q *Queue[T]) Count() int {
 
 	return q.count
 }
+
+// Capacity returns the maximum number of items the queue can currently hold.
+// It is the same as Size; the name makes clear that Size reports capacity, not the item count.
+func (q *Queue[T]) Capacity() int {
+	return q.Size()
+}
+
+// Len returns the current number of items in the queue.
+// It is the same as Count, named after the built-in len for callers who expect Size to mean the item count.
+func (q *Queue[T]) Len() int {
+	return q.Count()
+}
+
+// Utilization returns the fraction of the capacity in use, Count()/Capacity(),
+// ranging from 0 (empty) to 1 (full).
+// Both values are read under a single lock, so the result is consistent under concurrent use.
+func (q *Queue[T]) Utilization() float64 {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return float64(q.count) / float64(q.size)
+}
+
+// ToSlice returns a copy of the items in the queue in FIFO order,
+// so the first element is the item Dequeue would return next.
+// Items are copied out of the circular buffer, following the wrap-around
+// from the end of the buffer back to its start.
+func (q *Queue[T]) ToSlice() []T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	items := make([]T, q.count)
+	for i := range items {
+		items[i] = q.items[(q.head+i)%q.size]
+	}
+	return items
+}
+
+// All returns an iterator over the items in the queue in FIFO order, the same order as
+// ToSlice, for use with range-over-func and the collections package.
+// The items are copied when iteration starts, so the loop body may modify the queue and
+// sees the queue as it was at that moment.
+//
+// Example:
+//
+//	for item := range q.All() {
+//	    fmt.Println(item)
+//	}
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.ToSlice() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Contains reports whether value is currently buffered in the queue, without dequeuing anything.
+// It scans only the live items, starting at the front and following the circular buffer
+// for Count() slots, so values left in slots outside that window are never reported.
+// Contains is a function rather than a method because it requires T to be comparable,
+// which the Queue type itself does not.
+//
+// Time complexity: O(n) where n is the number of items in the queue.
+//
+// Example:
+//
+//	q := NewQueue[string](3)
+//	_ = q.Enqueue("job-1")
+//	Contains(q, "job-1") // true
+//	Contains(q, "job-2") // false
+func Contains[T comparable](q *Queue[T], value T) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	for i := 0; i < q.count; i++ {
+		if q.items[(q.head+i)%q.size] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal reports whether a and b hold the same items in the same FIFO order, without
+// dequeuing anything from either queue. Only the live items are compared, so queues with
+// different capacities, head positions or modes can be equal.
+// Equal is a function rather than a method because it requires T to be comparable,
+// which the Queue type itself does not.
+//
+// The queues are never locked at the same time: a's items are copied under its read lock
+// and then compared under b's, so Equal cannot deadlock with a concurrent Equal(b, a).
+//
+// Time complexity: O(n) where n is the number of items in a.
+//
+// Example:
+//
+//	assert.True(t, queue.Equal(q, q.Clone()))
+func Equal[T comparable](a, b *Queue[T]) bool {
+	if a == b {
+		return true
+	}
+	items := a.ToSlice()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if len(items) != b.count {
+		return false
+	}
+	for i, item := range items {
+		if b.items[(b.head+i)%b.size] != item {
+			return false
+		}
+	}
+	return true
+}
+
+// Clear removes all items from the queue while keeping its capacity.
+// The buffer is reused; its slots are zeroed to release references.
+func (q *Queue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	clear(q.items)
+	q.count = 0
+	q.head = 0
+	q.tail = 0
+}
+
+// Clone returns an independent copy of the queue with the same capacity and items.
+// The copy does not share its buffer with the original.
+func (q *Queue[T]) Clone() *Queue[T] {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	items := make([]T, q.size)
+	copy(items, q.items)
+	return &Queue[T]{
+		items:   items,
+		size:    q.size,
+		count:   q.count,
+		head:    q.head,
+		tail:    q.tail,
+		dynamic: q.dynamic,
+	}
+}
+
+// ShrinkToFit reallocates the buffer of a dynamic queue so that its capacity equals
+// the current number of items, but no less than the minimum dynamic capacity.
+// Use it to release memory after a burst has drained; the items and their FIFO order
+// are unchanged. A fixed-capacity queue is left as is, since its capacity is part of its contract.
+func (q *Queue[T]) ShrinkToFit() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.dynamic {
+		return
+	}
+	if capacity := max(q.count, minDynamicCapacity); capacity < q.size {
+		q.resize(capacity)
+	}
+}
+
+// resize moves the items into a new buffer that can hold capacity items,
+// re-linearizing them so that the front item is at index 0.
+// This method assumes the caller already holds the write lock.
+func (q *Queue[T]) resize(capacity int) {
+	items := make([]T, capacity)
+	for i := 0; i < q.count; i++ {
+		items[i] = q.items[(q.head+i)%q.size]
+	}
+	q.items = items
+	q.size = capacity
+	q.head = 0
+	q.tail = q.count % capacity
+}
@@ -2,6 +2,7 @@ package queue
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,134 @@ func TestNewQueue(t *testing.T) {
 	})
 }
 
+func TestNewDynamicQueue(t *testing.T) {
+	q := NewDynamicQueue[int]()
+	require.NotNil(t, q)
+	assert.True(t, q.IsEmpty())
+	assert.False(t, q.IsFull())
+	assert.Equal(t, 0, q.Count())
+	assert.Equal(t, minDynamicCapacity, q.Size())
+
+	_, err := q.Dequeue()
+	assert.Equal(t, ErrorQueueUnderflow, err)
+	_, err = q.Peek()
+	assert.Equal(t, ErrorQueueUnderflow, err)
+}
+
+func TestDynamicQueue_Grow(t *testing.T) {
+	q := NewDynamicQueue[int]()
+
+	// Offset head so that the first resize has to re-linearize a wrapped buffer
+	require.NoError(t, q.Enqueue(-2))
+	require.NoError(t, q.Enqueue(-1))
+	for _, want := range []int{-2, -1} {
+		got, err := q.Dequeue()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	const n = 1000
+	for i := 0; i < n; i++ {
+		require.NoError(t, q.Enqueue(i))
+		assert.False(t, q.IsFull())
+	}
+	assert.Equal(t, n, q.Count())
+	assert.GreaterOrEqual(t, q.Size(), n)
+
+	front, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, 0, front)
+
+	for i := 0; i < n; i++ {
+		got, err := q.Dequeue()
+		require.NoError(t, err)
+		assert.Equal(t, i, got)
+	}
+	assert.True(t, q.IsEmpty())
+}
+
+func TestQueue_ShrinkToFit(t *testing.T) {
+	t.Run("dynamic queue after a burst", func(t *testing.T) {
+		q := NewDynamicQueue[int]()
+		for i := 0; i < 100; i++ {
+			require.NoError(t, q.Enqueue(i))
+		}
+		// Drain most of the burst so the remaining items wrap around the buffer
+		for i := 0; i < 90; i++ {
+			_, err := q.Dequeue()
+			require.NoError(t, err)
+		}
+		for i := 100; i < 105; i++ {
+			require.NoError(t, q.Enqueue(i))
+		}
+		require.Greater(t, q.Size(), 100)
+
+		q.ShrinkToFit()
+		assert.Equal(t, 15, q.Size())
+		assert.Equal(t, 15, q.Count())
+		expected := make([]int, 0, 15)
+		for i := 90; i < 105; i++ {
+			expected = append(expected, i)
+		}
+		assert.Equal(t, expected, q.ToSlice())
+
+		// The queue keeps working, and grows again when needed
+		require.NoError(t, q.Enqueue(105))
+		front, err := q.Dequeue()
+		require.NoError(t, err)
+		assert.Equal(t, 90, front)
+	})
+
+	t.Run("never below the minimum capacity", func(t *testing.T) {
+		q := NewDynamicQueue[int]()
+		for i := 0; i < 20; i++ {
+			require.NoError(t, q.Enqueue(i))
+		}
+		for i := 0; i < 19; i++ {
+			_, err := q.Dequeue()
+			require.NoError(t, err)
+		}
+		q.ShrinkToFit()
+		assert.Equal(t, minDynamicCapacity, q.Size())
+		assert.Equal(t, []int{19}, q.ToSlice())
+	})
+
+	t.Run("fixed queue is unchanged", func(t *testing.T) {
+		q := NewQueue[int](10)
+		require.NoError(t, q.Enqueue(1))
+		q.ShrinkToFit()
+		assert.Equal(t, 10, q.Size())
+		assert.Equal(t, []int{1}, q.ToSlice())
+	})
+}
+
+func TestQueue_CapacityLenUtilization(t *testing.T) {
+	x := NewQueue[int](4)
+	assert.Equal(t, 4, x.Capacity())
+	assert.Equal(t, 0, x.Len())
+	assert.Equal(t, 0.0, x.Utilization())
+
+	require.NoError(t, x.Enqueue(1))
+	assert.Equal(t, x.Size(), x.Capacity())
+	assert.Equal(t, x.Count(), x.Len())
+	assert.Equal(t, 1, x.Len())
+	assert.Equal(t, 0.25, x.Utilization())
+
+	for i := 2; i <= 4; i++ {
+		require.NoError(t, x.Enqueue(i))
+	}
+	assert.Equal(t, 1.0, x.Utilization())
+
+	d := NewDynamicQueue[int]()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, d.Enqueue(i))
+	}
+	assert.Equal(t, d.Size(), d.Capacity())
+	assert.Equal(t, 100, d.Len())
+	assert.InDelta(t, float64(d.Len())/float64(d.Capacity()), d.Utilization(), 1e-9)
+	assert.LessOrEqual(t, d.Utilization(), 1.0)
+}
+
 func TestIsEmpty(t *testing.T) {
 	q := NewQueue[int](3)
 	assert.True(t, q.IsEmpty())
@@ -202,6 +331,253 @@ func TestCircularBehavior(t *testing.T) {
 	assert.True(t, q.IsEmpty())
 }
 
+func TestQueue_EnqueueAll(t *testing.T) {
+	t.Run("all items fit", func(t *testing.T) {
+		q := NewQueue[int](4)
+		n, err := q.EnqueueAll([]int{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []int{1, 2, 3}, q.ToSlice())
+	})
+
+	t.Run("partial fit returns overflow", func(t *testing.T) {
+		q := NewQueue[int](3)
+		require.NoError(t, q.Enqueue(0))
+		n, err := q.EnqueueAll([]int{1, 2, 3, 4})
+		assert.ErrorIs(t, err, ErrorQueueOverflow)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []int{0, 1, 2}, q.ToSlice())
+		assert.True(t, q.IsFull())
+	})
+
+	t.Run("wraps around the buffer", func(t *testing.T) {
+		q := NewQueue[int](3)
+		_, err := q.EnqueueAll([]int{1, 2})
+		require.NoError(t, err)
+		_, err = q.Dequeue()
+		require.NoError(t, err)
+		n, err := q.EnqueueAll([]int{3, 4})
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []int{2, 3, 4}, q.ToSlice())
+	})
+
+	t.Run("dynamic queue grows to fit", func(t *testing.T) {
+		q := NewDynamicQueue[int]()
+		require.NoError(t, q.Enqueue(0))
+		items := make([]int, 10)
+		for i := range items {
+			items[i] = i + 1
+		}
+		n, err := q.EnqueueAll(items)
+		require.NoError(t, err)
+		assert.Equal(t, 10, n)
+		assert.Equal(t, 16, q.Size())
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, q.ToSlice())
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		q := NewQueue[int](1)
+		n, err := q.EnqueueAll(nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.True(t, q.IsEmpty())
+	})
+}
+
+func TestQueue_DequeueN(t *testing.T) {
+	q := NewQueue[int](4)
+	_, err := q.DequeueN(1)
+	assert.ErrorIs(t, err, ErrorQueueUnderflow)
+
+	_, err = q.EnqueueAll([]int{1, 2, 3, 4})
+	require.NoError(t, err)
+	_, err = q.Dequeue()
+	require.NoError(t, err)
+	require.NoError(t, q.Enqueue(5)) // wraps around
+
+	items, err := q.DequeueN(0)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	assert.Equal(t, 4, q.Count())
+
+	items, err = q.DequeueN(3)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4}, items)
+
+	items, err = q.DequeueN(10)
+	require.NoError(t, err)
+	assert.Equal(t, []int{5}, items, "fewer than n items returns what is left")
+	assert.True(t, q.IsEmpty())
+
+	// The slots are cleared, so the queue can be refilled normally.
+	n, err := q.EnqueueAll([]int{6, 7, 8, 9})
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []int{6, 7, 8, 9}, q.ToSlice())
+}
+
+func TestQueue_ToSlice(t *testing.T) {
+	q := NewQueue[int](4)
+	assert.Equal(t, []int{}, q.ToSlice())
+
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, q.Enqueue(i))
+	}
+	assert.Equal(t, []int{1, 2, 3, 4}, q.ToSlice())
+
+	// Dequeue then enqueue so the items wrap around the end of the buffer
+	for i := 0; i < 3; i++ {
+		_, err := q.Dequeue()
+		require.NoError(t, err)
+	}
+	for i := 5; i <= 7; i++ {
+		require.NoError(t, q.Enqueue(i))
+	}
+	require.LessOrEqual(t, q.tail, q.head, "buffer should be wrapped")
+
+	items := q.ToSlice()
+	assert.Equal(t, []int{4, 5, 6, 7}, items)
+
+	// Modifying the returned slice must not affect the queue
+	items[0] = 99
+	front, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, 4, front)
+}
+
+func TestQueue_All(t *testing.T) {
+	q := NewQueue[int](3)
+	assert.Empty(t, slices.Collect(q.All()))
+
+	_, err := q.EnqueueAll([]int{0, 1, 2})
+	require.NoError(t, err)
+	_, err = q.Dequeue()
+	require.NoError(t, err)
+	require.NoError(t, q.Enqueue(3)) // wraps around
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(q.All()), "FIFO order")
+
+	var seen []int
+	for item := range q.All() {
+		seen = append(seen, item)
+		_, err := q.Dequeue() // modifying the queue inside the loop is allowed
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []int{1, 2, 3}, seen)
+	assert.True(t, q.IsEmpty())
+}
+
+func TestContains(t *testing.T) {
+	q := NewQueue[int](4)
+	assert.False(t, Contains(q, 0), "an empty queue contains nothing, not even the zero value")
+
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, q.Enqueue(i))
+	}
+	_, _ = q.Dequeue()
+	_, _ = q.Dequeue()
+	require.NoError(t, q.Enqueue(5))
+	require.NoError(t, q.Enqueue(6))
+	// The buffer has wrapped: the live window is [3 4 5 6], starting in the middle of the slice.
+	require.Equal(t, []int{3, 4, 5, 6}, q.ToSlice())
+
+	for _, v := range []int{3, 4, 5, 6} {
+		assert.True(t, Contains(q, v), "live item %d", v)
+	}
+	assert.False(t, Contains(q, 1), "dequeued items are gone")
+	assert.False(t, Contains(q, 2), "dequeued items are gone")
+
+	_, _ = q.Dequeue()
+	_, _ = q.Dequeue()
+	_, _ = q.Dequeue()
+	// Only 6 is live; its neighbours' slots are outside the window.
+	assert.True(t, Contains(q, 6))
+	assert.False(t, Contains(q, 5))
+	assert.False(t, Contains(q, 0))
+	assert.Equal(t, 1, q.Count(), "Contains must not dequeue")
+}
+
+func TestEqual(t *testing.T) {
+	a := NewQueue[int](3)
+	b := NewQueue[int](5)
+	assert.True(t, Equal(a, b), "empty queues are equal")
+	assert.True(t, Equal(a, a))
+
+	_, err := a.EnqueueAll([]int{0, 1, 2})
+	require.NoError(t, err)
+	_, err = a.Dequeue()
+	require.NoError(t, err)
+	require.NoError(t, a.Enqueue(3)) // wraps around
+	_, err = b.EnqueueAll([]int{1, 2, 3})
+	require.NoError(t, err)
+
+	assert.True(t, Equal(a, b), "different capacities and head positions")
+	assert.True(t, Equal(b, a))
+	assert.Equal(t, []int{1, 2, 3}, a.ToSlice(), "Equal does not consume items")
+	assert.Equal(t, 3, b.Count())
+
+	require.NoError(t, b.Enqueue(4))
+	assert.False(t, Equal(a, b), "different lengths")
+
+	c := NewQueue[int](3)
+	_, err = c.EnqueueAll([]int{1, 3, 2})
+	require.NoError(t, err)
+	assert.False(t, Equal(a, c), "same items in a different order")
+
+	assert.True(t, Equal(a, a.Clone()))
+}
+
+func TestQueue_Clear(t *testing.T) {
+	q := NewQueue[int](3)
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, q.Enqueue(i))
+	}
+	_, err := q.Dequeue()
+	require.NoError(t, err)
+	require.NoError(t, q.Enqueue(4))
+
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+	assert.Equal(t, 3, q.Size())
+	assert.Equal(t, []int{0, 0, 0}, q.items)
+	_, err = q.Dequeue()
+	assert.Equal(t, ErrorQueueUnderflow, err)
+
+	for i := 5; i <= 7; i++ {
+		require.NoError(t, q.Enqueue(i))
+	}
+	assert.Equal(t, []int{5, 6, 7}, q.ToSlice())
+}
+
+func TestQueue_Clone(t *testing.T) {
+	q := NewQueue[int](3)
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, q.Enqueue(i))
+	}
+	_, err := q.Dequeue()
+	require.NoError(t, err)
+	require.NoError(t, q.Enqueue(4)) // wrapped
+
+	clone := q.Clone()
+	assert.Equal(t, q.ToSlice(), clone.ToSlice())
+	assert.Equal(t, q.Size(), clone.Size())
+
+	_, err = clone.Dequeue()
+	require.NoError(t, err)
+	require.NoError(t, clone.Enqueue(5))
+
+	assert.Equal(t, []int{2, 3, 4}, q.ToSlice())
+	assert.Equal(t, []int{3, 4, 5}, clone.ToSlice())
+
+	dynamic := NewDynamicQueue[int]()
+	dynamicClone := dynamic.Clone()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, dynamicClone.Enqueue(i))
+	}
+	assert.Equal(t, 10, dynamicClone.Count())
+	assert.True(t, dynamic.IsEmpty())
+}
+
 func FuzzQueue_EnqueueDequeue(f *testing.F) {
 	f.Add(1)
 	f.Add(42)
@@ -0,0 +1,49 @@
+/*
+Package skiplist provides a generic, thread-safe skip list: an ordered map from keys to
+values with O(log n) expected search, insertion and deletion.
+
+A skip list keeps its entries in a sorted linked list and adds a hierarchy of "express
+lanes" above it. Each new entry is promoted to the next level with probability 1/2, so a
+level holds about half the entries of the one below, and a search skips over long runs
+of entries by starting at the top level and dropping down whenever the next key is too
+large. Unlike a balanced tree, no rotations are needed to stay efficient: the random
+levels keep it balanced in expectation.
+
+Compared with binary_search_tree.BinaryTree, which orders entries by the hash of their
+value, a SkipList orders entries by the key itself, so iteration and Range return keys in
+ascending order.
+
+# Performance Characteristics
+
+- Insert: O(log n) expected
+- Search: O(log n) expected
+- Delete: O(log n) expected
+- Range: O(log n + k) expected, where k is the number of entries returned
+- Len: O(1)
+- Space: O(n) expected (about two forward pointers per entry)
+
+# Basic Usage
+
+	list := skiplist.NewSkipList[int, string]()
+	list.Insert(30, "thirty")
+	list.Insert(10, "ten")
+	list.Insert(20, "twenty")
+
+	value, found := list.Search(20) // "twenty", true
+
+	for _, entry := range list.Range(15, 30) {
+		fmt.Println(entry.Key, entry.Value) // 20 twenty, then 30 thirty
+	}
+
+	if err := list.Delete(10); errors.Is(err, skiplist.ErrKeyNotFound) {
+		fmt.Println("10 was not in the list")
+	}
+
+Inserting an existing key replaces its value.
+
+# Thread Safety
+
+The skip list is thread-safe. Insert and Delete acquire an exclusive lock; Search,
+Range and Len acquire a shared lock, so concurrent readers do not block each other.
+*/
+package skiplist
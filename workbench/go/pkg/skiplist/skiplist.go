@@ -0,0 +1,178 @@
+package skiplist
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"sync"
+)
+
+// ErrKeyNotFound is returned when deleting a key that is not in the skip list.
+var ErrKeyNotFound = errors.New("key not found in skip list")
+
+// maxLevel is the maximum number of levels of the skip list.
+// With a promotion probability of 1/2 it comfortably covers 2^32 entries.
+const maxLevel = 32
+
+// Entry is a key-value pair stored in the skip list.
+type Entry[K cmp.Ordered, V any] struct {
+	Key   K
+	Value V
+}
+
+// node is an entry of the skip list together with its forward pointers.
+// next[i] is the following node on level i; len(next) is the node's level.
+type node[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+}
+
+// SkipList is a thread-safe ordered map from keys to values implemented as a skip list.
+// The zero value is not ready to use; use NewSkipList to create a new skip list.
+type SkipList[K cmp.Ordered, V any] struct {
+	// head is a sentinel node holding no entry, with a forward pointer on every level
+	head *node[K, V]
+	// level is the number of levels currently in use (at least 1)
+	level int
+	// size is the number of entries
+	size int
+	mu   sync.RWMutex
+}
+
+// NewSkipList creates and returns a new empty skip list.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  &node[K, V]{next: make([]*node[K, V], maxLevel)},
+		level: 1,
+	}
+}
+
+// Len returns the number of entries in the skip list.
+// This method is thread-safe and uses a read lock for concurrent access.
+func (s *SkipList[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.size
+}
+
+// Insert adds key with value to the skip list. If key is already present, its value is replaced.
+// This method is thread-safe and uses a write lock for concurrent access.
+// Time complexity: O(log n) expected.
+func (s *SkipList[K, V]) Insert(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*node[K, V]
+	current := s.findPredecessors(key, &update)
+	if next := current.next[0]; next != nil && next.key == key {
+		next.value = value
+		return
+	}
+
+	level := randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+		}
+		s.level = level
+	}
+
+	created := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level)}
+	for i := 0; i < level; i++ {
+		created.next[i] = update[i].next[i]
+		update[i].next[i] = created
+	}
+	s.size++
+}
+
+// Search returns the value stored for key.
+// The boolean reports whether the key was found; if it was not, the zero value is returned.
+// This method is thread-safe and uses a read lock for concurrent access.
+// Time complexity: O(log n) expected.
+func (s *SkipList[K, V]) Search(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if next := s.lowerBound(key); next != nil && next.key == key {
+		return next.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key and its value from the skip list.
+// If the key is not present, it returns ErrKeyNotFound.
+// This method is thread-safe and uses a write lock for concurrent access.
+// Time complexity: O(log n) expected.
+func (s *SkipList[K, V]) Delete(key K) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*node[K, V]
+	target := s.findPredecessors(key, &update).next[0]
+	if target == nil || target.key != key {
+		return ErrKeyNotFound
+	}
+
+	for i := range target.next {
+		update[i].next[i] = target.next[i]
+	}
+	// Drop levels that no longer hold any entry
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return nil
+}
+
+// Range returns the entries whose keys lie within [lo, hi], in ascending key order.
+// If lo > hi or no key falls within the bounds, it returns an empty slice.
+// This method is thread-safe and uses a read lock for concurrent access.
+// Time complexity: O(log n + k) expected, where k is the number of entries returned.
+func (s *SkipList[K, V]) Range(lo, hi K) []Entry[K, V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := []Entry[K, V]{}
+	for current := s.lowerBound(lo); current != nil && current.key <= hi; current = current.next[0] {
+		entries = append(entries, Entry[K, V]{Key: current.key, Value: current.value})
+	}
+	return entries
+}
+
+// findPredecessors walks down from the top level and records in update, for each level
+// in use, the last node whose key is less than key. It returns the predecessor on level 0.
+// This method assumes the caller already holds the lock.
+func (s *SkipList[K, V]) findPredecessors(key K, update *[maxLevel]*node[K, V]) *node[K, V] {
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && current.next[i].key < key {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+	return current
+}
+
+// lowerBound returns the first node whose key is greater than or equal to key, or nil if none exists.
+// This method assumes the caller already holds the lock.
+func (s *SkipList[K, V]) lowerBound(key K) *node[K, V] {
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && current.next[i].key < key {
+			current = current.next[i]
+		}
+	}
+	return current.next[0]
+}
+
+// randomLevel returns a random level for a new node: 1 with probability 1/2,
+// 2 with probability 1/4, and so on, capped at maxLevel.
+func randomLevel() int {
+	level := 1
+	for level < maxLevel && rand.IntN(2) == 0 {
+		level++
+	}
+	return level
+}
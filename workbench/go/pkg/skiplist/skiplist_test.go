@@ -0,0 +1,161 @@
+package skiplist
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+// checkInvariants verifies that every level is sorted and is a subsequence of the level below.
+func checkInvariants[K int | string, V any](t *testing.T, s *SkipList[K, V]) {
+	t.Helper()
+	count := 0
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		count++
+		if n.next[0] != nil {
+			require.Less(t, n.key, n.next[0].key, "level 0 must be strictly ascending")
+		}
+	}
+	require.Equal(t, s.size, count)
+
+	for level := 1; level < s.level; level++ {
+		below := map[*node[K, V]]bool{}
+		for n := s.head.next[level-1]; n != nil; n = n.next[level-1] {
+			below[n] = true
+		}
+		for n := s.head.next[level]; n != nil; n = n.next[level] {
+			require.True(t, below[n], "level %d must be a subsequence of level %d", level, level-1)
+		}
+	}
+	for level := s.level; level < maxLevel; level++ {
+		require.Nil(t, s.head.next[level], "levels above s.level must be empty")
+	}
+}
+
+func TestSkipList_InsertSearch(t *testing.T) {
+	s := NewSkipList[int, string]()
+	assert.Equal(t, 0, s.Len())
+	_, found := s.Search(1)
+	assert.False(t, found)
+
+	s.Insert(30, "thirty")
+	s.Insert(10, "ten")
+	s.Insert(20, "twenty")
+	assert.Equal(t, 3, s.Len())
+
+	for key, want := range map[int]string{10: "ten", 20: "twenty", 30: "thirty"} {
+		got, found := s.Search(key)
+		assert.True(t, found)
+		assert.Equal(t, want, got)
+	}
+	_, found = s.Search(15)
+	assert.False(t, found)
+
+	s.Insert(20, "TWENTY")
+	assert.Equal(t, 3, s.Len(), "inserting an existing key replaces its value")
+	got, _ := s.Search(20)
+	assert.Equal(t, "TWENTY", got)
+	checkInvariants(t, s)
+}
+
+func TestSkipList_Delete(t *testing.T) {
+	s := NewSkipList[string, int]()
+	for i, k := range []string{"b", "a", "d", "c"} {
+		s.Insert(k, i)
+	}
+
+	require.NoError(t, s.Delete("c"))
+	assert.Equal(t, 3, s.Len())
+	_, found := s.Search("c")
+	assert.False(t, found)
+	assert.ErrorIs(t, s.Delete("c"), ErrKeyNotFound)
+	assert.ErrorIs(t, s.Delete("z"), ErrKeyNotFound)
+	checkInvariants(t, s)
+
+	for _, k := range []string{"a", "b", "d"} {
+		require.NoError(t, s.Delete(k))
+	}
+	assert.Equal(t, 0, s.Len())
+	assert.Equal(t, 1, s.level, "empty levels are dropped")
+	checkInvariants(t, s)
+}
+
+func TestSkipList_Range(t *testing.T) {
+	s := NewSkipList[int, int]()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		s.Insert(k, k*10)
+	}
+
+	keys := func(entries []Entry[int, int]) []int {
+		out := make([]int, len(entries))
+		for i, e := range entries {
+			out[i] = e.Key
+		}
+		return out
+	}
+
+	assert.Equal(t, []int{20, 30, 40}, keys(s.Range(15, 45)))
+	assert.Equal(t, []int{10, 20, 30, 40, 50}, keys(s.Range(10, 50)), "bounds are inclusive")
+	assert.Equal(t, []Entry[int, int]{{Key: 30, Value: 300}}, s.Range(30, 30))
+	assert.Empty(t, s.Range(60, 70))
+	assert.NotNil(t, s.Range(45, 15), "an inverted range yields an empty slice")
+	assert.Empty(t, s.Range(45, 15))
+}
+
+func TestSkipList_AgainstSortedSlice(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	s := NewSkipList[int, int]()
+	reference := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(1000)
+		if random.Intn(3) == 0 {
+			err := s.Delete(key)
+			if _, ok := reference[key]; ok {
+				require.NoError(t, err)
+				delete(reference, key)
+			} else {
+				require.ErrorIs(t, err, ErrKeyNotFound)
+			}
+		} else {
+			s.Insert(key, i)
+			reference[key] = i
+		}
+	}
+	checkInvariants(t, s)
+
+	want := make([]int, 0, len(reference))
+	for k := range reference {
+		want = append(want, k)
+	}
+	slices.Sort(want)
+	all := s.Range(-1, 1000)
+	require.Len(t, all, len(want))
+	for i, e := range all {
+		assert.Equal(t, want[i], e.Key)
+		assert.Equal(t, reference[e.Key], e.Value)
+	}
+	assert.Equal(t, len(reference), s.Len())
+}
+
+func TestSkipList_Concurrent(t *testing.T) {
+	s := NewSkipList[int, int]()
+	var g errgroup.Group
+	for w := 0; w < 8; w++ {
+		g.Go(func() error {
+			for i := 0; i < 200; i++ {
+				s.Insert(w*1000+i, i)
+				s.Search(i)
+				s.Range(0, 100)
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait())
+	assert.Equal(t, 1600, s.Len())
+	checkInvariants(t, s)
+}
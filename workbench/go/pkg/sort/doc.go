@@ -2,13 +2,21 @@
 Package sort provides efficient sorting algorithms with generic type support.
 
 This package implements various sorting algorithms optimized for different use cases.
-All sorting functions work with any type that implements the cmp.Ordered interface,
-providing type safety and performance.
+The sorting functions work with any type that implements the cmp.Ordered interface,
+providing type safety and performance; the Func variants accept any type with a
+custom less function.
 
 # Available Algorithms
 
 - HeapSort: O(n log n) time complexity, O(1) extra space, not stable
+- StableHeapSort: HeapSort made stable by tie-breaking on original index, O(n) extra space
 - QuickSort: O(n log n) average case, O(n²) worst case, O(log n) extra space, not stable
+- QuickSort3Way: QuickSort with three-way partitioning, close to O(n) on duplicate-heavy input
+- IntroSort: QuickSort that falls back to HeapSort past 2*log2(n) levels, O(n log n) guaranteed, not stable
+- MergeSort: O(n log n) time complexity, O(n) extra space, stable
+- InsertionSort: O(n²) worst case, O(n) on sorted input, O(1) extra space, stable
+- CountingSort: O(n + r) for []int whose values span a range of r, O(n + r) extra space
+- RadixSort: O(n) passes per byte of the value range for []int, O(n) extra space, stable
 
 # Performance Characteristics
 
@@ -23,6 +31,20 @@ QuickSort:
 - Space: O(log n) for recursion stack
 - Stability: Not stable
 - Best for: Average case performance, cache-friendly access patterns
+- Pivot: median of the first, middle and last elements, so sorted input stays O(n log n)
+- Subarrays shorter than 12 elements are finished with insertion sort
+
+MergeSort:
+- Time: O(n log n) guaranteed
+- Space: O(n) auxiliary buffer
+- Stability: Stable
+- Best for: Preserving the input order of equal elements
+
+InsertionSort:
+- Time: O(n²) worst case, O(n) best case
+- Space: O(1) extra space
+- Stability: Stable
+- Best for: Small or nearly sorted inputs
 
 # Basic Usage
 
@@ -43,6 +65,98 @@ QuickSort:
 	sorted = sort.HeapSort(prices)
 	// sorted: [4.99, 9.99, 19.99, 29.99]
 
+heap.HeapSort in the heap package sorts a []*T. HeapSortValues wraps it for value
+slices: it builds the pointer slice internally and returns the sorted values, with
+no pointers to dereference and no error to check:
+
+	sorted = sort.HeapSortValues([]int{5, 2, 9, 1})
+	// sorted: [1, 2, 5, 9]
+
+# In-Place Sorting
+
+HeapSort, QuickSort and the other functions above return a new slice and never modify
+their input. For very large inputs where that copy doubles memory use, HeapSortInPlace
+and QuickSortInPlace sort the caller's slice directly and return nothing; the original
+order is lost.
+
+	numbers := []int{64, 34, 25, 12}
+	sort.QuickSortInPlace(numbers)
+	// numbers: [12, 25, 34, 64]
+
+# Custom Ordering
+
+SortFunc and MergeSortFunc accept any element type together with a less function,
+so slices of structs can be sorted by a field. Like the other functions they return
+a new slice and leave the input untouched. MergeSortFunc is stable; SortFunc is not.
+
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{{"Alice", 30}, {"Bob", 25}}
+	byAge := sort.MergeSortFunc(people, func(a, b Person) bool { return a.Age < b.Age })
+	// byAge: [{Bob 25} {Alice 30}]
+
+StableHeapSort also takes a less function and is stable: it decorates each element
+with its original index and breaks ties on it, giving heap sort's guaranteed
+O(n log n) without reordering equal elements.
+
+# Order Helpers
+
+IsSorted reports whether a slice is in ascending order, stopping at the first pair that
+is out of order. SortDescending returns a new slice in descending order and, like the
+rest of the package, leaves its input untouched.
+
+	sort.IsSorted([]int{1, 2, 3})             // true
+	desc := sort.SortDescending([]int{1, 3, 2}) // [3, 2, 1]
+
+# Selection
+
+TopK and NthElement use QuickSelect to avoid sorting the whole slice.
+TopK returns the k largest elements in descending order, and NthElement returns
+the element that would sit at a given index after sorting, both in expected O(n) time.
+
+	scores := []int{50, 90, 10, 70, 30, 80}
+	top := sort.TopK(scores, 3)              // [90, 80, 70]
+	median, err := sort.NthElement(scores, 3) // 70, nil
+
+# Merging Sorted Slices
+
+MergeK merges any number of already-sorted slices into one sorted slice in O(N log k),
+using a min-heap that holds the next element of each input:
+
+	merged := sort.MergeK([]int{1, 4, 7}, []int{2, 5, 8}, []int{3, 6, 9})
+	// merged: [1, 2, 3, 4, 5, 6, 7, 8, 9]
+
+# Binary Search
+
+BinarySearch, LowerBound and UpperBound locate values in a slice that is already sorted
+in ascending order, in O(log n). LowerBound and UpperBound return insertion points, so
+together they delimit every element equal to a value:
+
+	data := sort.MergeSort([]int{30, 10, 20, 20})  // [10, 20, 20, 30]
+	i, found := sort.BinarySearch(data, 20)         // 1, true
+	equal := data[sort.LowerBound(data, 20):sort.UpperBound(data, 20)] // [20, 20]
+
+# Sliding Window Maximum
+
+SlidingWindowMax returns the maximum of every window of k consecutive elements in O(n),
+using a monotonic queue.Deque of indices.
+
+	maxima := sort.SlidingWindowMax([]int{1, 3, -1, -3, 5, 3, 6, 7}, 3)
+	// maxima: [3, 3, 5, 5, 6, 7]
+
+# Instrumentation
+
+QuickSortInstrumented, HeapSortInstrumented and MergeSortInstrumented run the same
+algorithms as their uninstrumented counterparts and also return a SortMetrics with the
+number of comparisons, swaps, other element moves and the maximum recursion depth.
+They are meant for study rather than speed:
+
+	_, qm := sort.QuickSortInstrumented(data)
+	_, hm := sort.HeapSortInstrumented(data)
+	fmt.Println(qm.Comparisons, hm.Comparisons, qm.MaxDepth)
+
 # Algorithm Selection Guide
 
 Use HeapSort when:
@@ -55,6 +169,23 @@ Use QuickSort when:
 - You have good cache locality requirements
 - The dataset is expected to be somewhat randomized
 
+Use IntroSort when:
+- You want QuickSort's average speed without its O(n²) worst case, e.g. on untrusted input
+
+Use QuickSort3Way when:
+- The data contains many duplicate keys
+
+Use InsertionSort when:
+- The slice is small or already nearly sorted
+
+Use CountingSort or RadixSort when:
+- You are sorting large []int inputs and want to avoid comparisons
+- CountingSort only when the values fall in a small range, since it allocates one counter per value
+
+Use MergeSort when:
+- Equal elements must keep their original relative order
+- You need guaranteed O(n log n) performance and can afford O(n) extra space
+
 # Thread Safety
 
 The sorting functions in this package are not thread-safe. If you need to sort
@@ -65,3 +65,150 @@ func HeapSort[T cmp.Ordered](arr []T) ([]T, error) {
 	}
 	return fromPointerSlice(ptrs), nil
 }
+
+// HeapSortValues returns a new slice containing the elements of data sorted in ascending order.
+//
+// It sorts with heap.HeapSort, which works on []*T, but hides the pointers: the pointer slice
+// is built internally and the result is flattened back to values, so callers with value
+// semantics never dereference elements. Unlike HeapSort it returns no error, because
+// heap.HeapSort cannot fail on a slice it builds itself. The input slice is not modified.
+//
+// Time Complexity: O(n log n) - guaranteed for all cases
+// Space Complexity: O(n) for the pointer slice and the result
+// Stability: Not stable
+//
+// Example:
+//
+//	sorted := sort.HeapSortValues([]int{5, 2, 9, 1})
+//	// sorted: [1, 2, 5, 9]
+func HeapSortValues[T cmp.Ordered](data []T) []T {
+	// heap.HeapSort only fails on an out-of-range sift index, which cannot happen here.
+	ptrs, _ := heap.HeapSort(toPointerSlice(data))
+	return fromPointerSlice(ptrs)
+}
+
+// HeapSortInPlace sorts the caller's slice in ascending order using heap sort.
+//
+// Unlike HeapSort it does not allocate: the slice passed in is rearranged directly
+// and nothing is returned, so the original order is lost. Use it when the extra copy
+// made by HeapSort would be too costly for large inputs.
+//
+// Time Complexity: O(n log n) - guaranteed for all cases
+// Space Complexity: O(1) extra space
+// Stability: Not stable
+//
+// Example:
+//
+//	numbers := []int{64, 34, 25, 12, 22, 11, 90}
+//	sort.HeapSortInPlace(numbers)
+//	// numbers: [11, 12, 22, 25, 34, 64, 90]
+func HeapSortInPlace[T cmp.Ordered](arr []T) {
+	n := len(arr)
+	// Build a max heap bottom-up, starting from the last parent
+	for i := heap.Parent(n - 1); i >= 0; i-- {
+		siftDown(arr, i, n)
+	}
+	// Move the maximum behind the shrinking heap and restore the heap property
+	for end := n - 1; end > 0; end-- {
+		arr[0], arr[end] = arr[end], arr[0]
+		siftDown(arr, 0, end)
+	}
+}
+
+// siftDown moves arr[i] down the max heap stored in arr[:size] until
+// neither child is larger than it.
+func siftDown[T cmp.Ordered](arr []T, i, size int) {
+	for {
+		largest := i
+		left, right := heap.Left(i), heap.Right(i)
+		if left < size && arr[left] > arr[largest] {
+			largest = left
+		}
+		if right < size && arr[right] > arr[largest] {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		arr[i], arr[largest] = arr[largest], arr[i]
+		i = largest
+	}
+}
+
+// indexed pairs a value with its position in the input, so that ties between equal
+// values can be broken by original order.
+type indexed[T any] struct {
+	value T
+	index int
+}
+
+// StableHeapSort returns a new slice containing the elements sorted in ascending order
+// according to less, keeping equal elements in their original relative order.
+//
+// Heap sort on its own is not stable. StableHeapSort makes it stable with a Schwartzian
+// transform: each element is decorated with its original index, the decorated elements
+// are heap sorted with ties broken by that index, and the decoration is then stripped.
+// The input slice is not modified.
+//
+// Time Complexity: O(n log n) - guaranteed for all cases
+// Space Complexity: O(n) for the decorated copy
+// Stability: Stable
+//
+// Example:
+//
+//	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}}
+//	byAge := sort.StableHeapSort(people, func(a, b Person) bool { return a.Age < b.Age })
+//	// byAge: [{Bob 25} {Alice 30} {Carol 30}]
+func StableHeapSort[T any](data []T, less func(a, b T) bool) []T {
+	decorated := make([]indexed[T], len(data))
+	for i, v := range data {
+		decorated[i] = indexed[T]{value: v, index: i}
+	}
+
+	// Equal values are ordered by index, so no two decorated elements compare equal
+	// and the otherwise unstable sort has exactly one valid output.
+	lessIndexed := func(a, b indexed[T]) bool {
+		if less(a.value, b.value) {
+			return true
+		}
+		if less(b.value, a.value) {
+			return false
+		}
+		return a.index < b.index
+	}
+
+	n := len(decorated)
+	for i := heap.Parent(n - 1); i >= 0; i-- {
+		siftDownFunc(decorated, i, n, lessIndexed)
+	}
+	for end := n - 1; end > 0; end-- {
+		decorated[0], decorated[end] = decorated[end], decorated[0]
+		siftDownFunc(decorated, 0, end, lessIndexed)
+	}
+
+	sorted := make([]T, n)
+	for i, d := range decorated {
+		sorted[i] = d.value
+	}
+	return sorted
+}
+
+// siftDownFunc moves arr[i] down the max heap (ordered by less) stored in arr[:size]
+// until neither child is larger than it.
+func siftDownFunc[T any](arr []T, i, size int, less func(a, b T) bool) {
+	for {
+		largest := i
+		left, right := heap.Left(i), heap.Right(i)
+		if left < size && less(arr[largest], arr[left]) {
+			largest = left
+		}
+		if right < size && less(arr[largest], arr[right]) {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		arr[i], arr[largest] = arr[largest], arr[i]
+		i = largest
+	}
+}
@@ -117,6 +117,33 @@ func TestHeapSort_CustomOrderedType(t *testing.T) {
 	assert.Equal(t, expected, result, "HeapSort should work with custom ordered types")
 }
 
+func TestHeapSortValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{"empty", nil, []int{}},
+		{"single element", []int{42}, []int{42}},
+		{"duplicates and negatives", []int{3, -1, 4, 1, -5, 3}, []int{-5, -1, 1, 3, 3, 4}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]int(nil), tt.input...)
+			assert.Equal(t, tt.want, HeapSortValues(tt.input))
+			assert.Equal(t, original, tt.input, "HeapSortValues should not modify its input")
+		})
+	}
+
+	t.Run("matches HeapSort", func(t *testing.T) {
+		words := []string{"pear", "apple", "fig", "banana"}
+		want, err := HeapSort(words)
+		require.NoError(t, err)
+		assert.Equal(t, want, HeapSortValues(words))
+	})
+}
+
 func TestHeapSort_CorrectnessAgainstStandardLibrary(t *testing.T) {
 	// Test multiple random datasets against Go's standard library sort
 	for i := 0; i < 100; i++ {
@@ -199,3 +226,46 @@ func BenchmarkHeapSort_Reverse1000(b *testing.B) {
 		_, _ = HeapSort(data)
 	}
 }
+
+func TestStableHeapSort(t *testing.T) {
+	type record struct {
+		Key int
+		Seq int
+	}
+	byKey := func(a, b record) bool { return a.Key < b.Key }
+
+	t.Run("empty and single", func(t *testing.T) {
+		assert.Empty(t, StableHeapSort([]record{}, byKey))
+		assert.Equal(t, []record{{1, 0}}, StableHeapSort([]record{{1, 0}}, byKey))
+	})
+
+	t.Run("preserves order of equal keys", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		data := make([]record, 500)
+		for i := range data {
+			data[i] = record{Key: r.Intn(10), Seq: i}
+		}
+		original := make([]record, len(data))
+		copy(original, data)
+
+		sorted := StableHeapSort(data, byKey)
+		require.Len(t, sorted, len(data))
+		assert.Equal(t, original, data, "input must not be modified")
+
+		for i := 1; i < len(sorted); i++ {
+			require.LessOrEqual(t, sorted[i-1].Key, sorted[i].Key, "keys out of order at %d", i)
+			if sorted[i-1].Key == sorted[i].Key {
+				require.Less(t, sorted[i-1].Seq, sorted[i].Seq, "equal keys reordered at %d", i)
+			}
+		}
+	})
+
+	t.Run("matches stable standard library sort", func(t *testing.T) {
+		data := []record{{3, 0}, {1, 1}, {3, 2}, {2, 3}, {1, 4}, {3, 5}, {2, 6}}
+		expected := make([]record, len(data))
+		copy(expected, data)
+		sort.SliceStable(expected, func(i, j int) bool { return expected[i].Key < expected[j].Key })
+
+		assert.Equal(t, expected, StableHeapSort(data, byKey))
+	})
+}
@@ -0,0 +1,57 @@
+package sort
+
+import (
+	"cmp"
+)
+
+// insertionSortThreshold is the subarray length below which QuickSort switches to
+// insertion sort, whose low overhead beats recursive partitioning on tiny inputs.
+const insertionSortThreshold = 12
+
+// InsertionSort sorts a slice using the insertion sort algorithm.
+//
+// InsertionSort has O(n²) worst-case time complexity but very little overhead and runs
+// in O(n) time on already sorted input, which makes it the fastest choice for small or
+// nearly sorted slices. It is stable.
+//
+// The algorithm works by:
+// 1. Treating the first element as a sorted prefix
+// 2. Taking the next element and shifting larger elements of the prefix one slot right
+// 3. Inserting the element into the gap and repeating until the whole slice is sorted
+//
+// Time Complexity: O(n²) worst case, O(n) best case (already sorted)
+// Space Complexity: O(n) for the result, O(1) extra space while sorting
+// Stability: Stable
+//
+// Parameters:
+//   - arr: slice of any ordered type to be sorted
+//
+// Returns:
+//   - A new slice containing the elements sorted in ascending order
+//
+// Example:
+//
+//	numbers := []int{5, 2, 4, 6, 1, 3}
+//	sorted := sort.InsertionSort(numbers)
+//	// sorted: [1, 2, 3, 4, 5, 6]
+func InsertionSort[T cmp.Ordered](arr []T) []T {
+	// Create a copy to avoid modifying the original slice
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	insertionSortInPlace(result, 0, len(result)-1)
+	return result
+}
+
+// insertionSortInPlace sorts the subarray arr[low..high] in place using insertion sort.
+func insertionSortInPlace[T cmp.Ordered](arr []T, low, high int) {
+	for i := low + 1; i <= high; i++ {
+		value := arr[i]
+		j := i - 1
+		for j >= low && arr[j] > value {
+			arr[j+1] = arr[j]
+			j--
+		}
+		arr[j+1] = value
+	}
+}
@@ -0,0 +1,91 @@
+package sort
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertionSort(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []int
+		expected []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single", []int{42}, []int{42}},
+		{"already sorted", []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		{"with duplicates", []int{3, 1, 4, 1, 5, 9, 2, 6, 5}, []int{1, 1, 2, 3, 4, 5, 5, 6, 9}},
+		{"negative numbers", []int{-5, -1, -10, 0, 3, -3}, []int{-10, -5, -3, -1, 0, 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := make([]int, len(tc.data))
+			copy(original, tc.data)
+
+			assert.Equal(t, tc.expected, InsertionSort(tc.data))
+			assert.Equal(t, original, tc.data, "InsertionSort should not modify original slice")
+		})
+	}
+}
+
+func TestInsertionSort_CorrectnessAgainstStandardLibrary(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		size := rand.Intn(100) + 1 // 1 to 100 elements
+		data := make([]int, size)
+		for j := 0; j < size; j++ {
+			data[j] = rand.Intn(1000)
+		}
+
+		expected := make([]int, len(data))
+		copy(expected, data)
+		sort.Ints(expected)
+
+		assert.Equal(t, expected, InsertionSort(data), "InsertionSort result should match standard library sort for dataset %d", i)
+	}
+}
+
+func TestQuickSort_AroundInsertionSortThreshold(t *testing.T) {
+	// Sizes on both sides of the cutoff exercise the hybrid switch-over
+	for size := insertionSortThreshold - 2; size <= insertionSortThreshold+2; size++ {
+		data := make([]int, size)
+		for i := range data {
+			data[i] = rand.Intn(20)
+		}
+
+		expected := make([]int, len(data))
+		copy(expected, data)
+		sort.Ints(expected)
+
+		assert.Equal(t, expected, QuickSort(data), "QuickSort should sort %d elements", size)
+	}
+}
+
+// Benchmark tests
+func BenchmarkInsertionSort_Random100(b *testing.B) {
+	data := make([]int, 100)
+	for i := range data {
+		data[i] = rand.Intn(1000)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		InsertionSort(data)
+	}
+}
+
+func BenchmarkInsertionSort_Sorted1000(b *testing.B) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		InsertionSort(data)
+	}
+}
@@ -0,0 +1,129 @@
+package sort
+
+// radixBits is the number of bits RadixSort processes per pass.
+const radixBits = 8
+
+// countingSortSlack is how many counters beyond 2n CountingSort allocates before it
+// falls back to RadixSort.
+const countingSortSlack = 256
+
+// CountingSort sorts a slice of integers by counting occurrences of each value.
+//
+// CountingSort runs in linear time when the values lie in a small range: it allocates
+// one counter per value between the minimum and the maximum, so its memory use grows
+// with max-min rather than with the number of elements. Negative values are handled by
+// offsetting every value by the minimum. When the range is much larger than the input
+// (more than 2n + 256 values, including ranges that overflow int), the counters would
+// waste memory, so CountingSort falls back to RadixSort instead.
+//
+// Time Complexity: O(n + r) where r = max - min + 1, or RadixSort's when r is large
+// Space Complexity: O(n + r)
+// Stability: Stable (equal integers are indistinguishable)
+//
+// Parameters:
+//   - arr: slice of integers to be sorted
+//
+// Returns:
+//   - A new slice containing the elements sorted in ascending order
+//
+// Example:
+//
+//	ages := []int{34, 12, 34, 7, 21}
+//	sorted := sort.CountingSort(ages)
+//	// sorted: [7, 12, 21, 34, 34]
+func CountingSort(arr []int) []int {
+	result := make([]int, len(arr))
+	if len(arr) == 0 {
+		return result
+	}
+
+	minValue, maxValue := arr[0], arr[0]
+	for _, v := range arr[1:] {
+		minValue = min(minValue, v)
+		maxValue = max(maxValue, v)
+	}
+
+	// Unsigned subtraction keeps the range exact even when max - min overflows int.
+	maxKey := uint64(maxValue) - uint64(minValue)
+	if maxKey >= uint64(2*len(arr)+countingSortSlack) {
+		return RadixSort(arr)
+	}
+
+	counts := make([]int, maxKey+1)
+	for _, v := range arr {
+		counts[v-minValue]++
+	}
+
+	i := 0
+	for offset, count := range counts {
+		for ; count > 0; count-- {
+			result[i] = offset + minValue
+			i++
+		}
+	}
+	return result
+}
+
+// RadixSort sorts a slice of integers using least-significant-digit radix sort.
+//
+// Each value is offset by the minimum so that every key is non-negative, and the keys are
+// then distributed by one byte at a time, from the lowest byte up, with a stable counting
+// pass per byte. Passes stop once the remaining high bytes are zero for every key, so
+// small ranges need only a few passes regardless of the magnitude of the values.
+//
+// Time Complexity: O(d·(n + 256)) where d is the number of bytes in max - min
+// Space Complexity: O(n)
+// Stability: Stable
+//
+// Parameters:
+//   - arr: slice of integers to be sorted
+//
+// Returns:
+//   - A new slice containing the elements sorted in ascending order
+//
+// Example:
+//
+//	numbers := []int{170, -45, 75, 90, -802, 24, 2, 66}
+//	sorted := sort.RadixSort(numbers)
+//	// sorted: [-802, -45, 2, 24, 66, 75, 90, 170]
+func RadixSort(arr []int) []int {
+	result := make([]int, len(arr))
+	copy(result, arr)
+	if len(result) <= 1 {
+		return result
+	}
+
+	minValue, maxValue := result[0], result[0]
+	for _, v := range result[1:] {
+		minValue = min(minValue, v)
+		maxValue = max(maxValue, v)
+	}
+	// Unsigned subtraction keeps the offsets exact even when max - min overflows int.
+	maxKey := uint64(maxValue) - uint64(minValue)
+
+	buf := make([]int, len(result))
+	for shift := uint(0); shift < 64 && maxKey>>shift > 0; shift += radixBits {
+		var counts [1 << radixBits]int
+		for _, v := range result {
+			counts[digit(v, minValue, shift)]++
+		}
+		// Turn counts into starting positions for each digit
+		position := 0
+		for d, count := range counts {
+			counts[d] = position
+			position += count
+		}
+		for _, v := range result {
+			d := digit(v, minValue, shift)
+			buf[counts[d]] = v
+			counts[d]++
+		}
+		result, buf = buf, result
+	}
+	return result
+}
+
+// digit extracts the radix digit of v's offset key at the given bit shift.
+func digit(v, minValue int, shift uint) int {
+	return int((uint64(v) - uint64(minValue)) >> shift & (1<<radixBits - 1))
+}
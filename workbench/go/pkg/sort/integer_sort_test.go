@@ -0,0 +1,89 @@
+package sort
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountingSort(t *testing.T) {
+	assert.Empty(t, CountingSort(nil))
+
+	original := []int{3, -1, 4, -1, 5, 0, 2}
+	result := CountingSort(original)
+	assert.Equal(t, []int{-1, -1, 0, 2, 3, 4, 5}, result)
+	assert.Equal(t, []int{3, -1, 4, -1, 5, 0, 2}, original, "CountingSort should not modify original slice")
+
+	t.Run("wide range falls back to RadixSort", func(t *testing.T) {
+		original := []int{1 << 40, 0, 5, 1 << 40, -(1 << 40)}
+		assert.Equal(t, []int{-(1 << 40), 0, 5, 1 << 40, 1 << 40}, CountingSort(original))
+		assert.Equal(t, []int{1 << 40, 0, 5, 1 << 40, -(1 << 40)}, original, "CountingSort should not modify original slice")
+	})
+
+	t.Run("full int range", func(t *testing.T) {
+		assert.Equal(t, []int{math.MinInt, math.MaxInt}, CountingSort([]int{math.MinInt, math.MaxInt}))
+		data := []int{math.MaxInt, 0, math.MinInt, -1, 1}
+		assert.Equal(t, []int{math.MinInt, -1, 0, 1, math.MaxInt}, CountingSort(data))
+	})
+}
+
+func TestRadixSort(t *testing.T) {
+	assert.Empty(t, RadixSort(nil))
+	assert.Equal(t, []int{7}, RadixSort([]int{7}))
+
+	original := []int{170, -45, 75, 90, -802, 24, 2, 66}
+	result := RadixSort(original)
+	assert.Equal(t, []int{-802, -45, 2, 24, 66, 75, 90, 170}, result)
+	assert.Equal(t, []int{170, -45, 75, 90, -802, 24, 2, 66}, original, "RadixSort should not modify original slice")
+
+	t.Run("full int range", func(t *testing.T) {
+		data := []int{math.MaxInt, 0, math.MinInt, -1, 1, math.MinInt + 1, math.MaxInt - 1}
+		expected := []int{math.MinInt, math.MinInt + 1, -1, 0, 1, math.MaxInt - 1, math.MaxInt}
+		assert.Equal(t, expected, RadixSort(data))
+	})
+}
+
+func TestIntegerSorts_CorrectnessAgainstStandardLibrary(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		size := rand.Intn(500) + 1
+		data := make([]int, size)
+		for j := range data {
+			data[j] = rand.Intn(20000) - 10000
+		}
+
+		expected := make([]int, len(data))
+		copy(expected, data)
+		sort.Ints(expected)
+
+		assert.Equal(t, expected, CountingSort(data), "CountingSort dataset %d", i)
+		assert.Equal(t, expected, RadixSort(data), "RadixSort dataset %d", i)
+	}
+}
+
+// Benchmark tests
+func BenchmarkCountingSort_Random10000(b *testing.B) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = rand.Intn(100000)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		CountingSort(data)
+	}
+}
+
+func BenchmarkRadixSort_Random10000(b *testing.B) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = rand.Intn(100000)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		RadixSort(data)
+	}
+}
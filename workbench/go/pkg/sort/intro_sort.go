@@ -0,0 +1,64 @@
+package sort
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// IntroSort sorts a slice using introsort, the hybrid strategy used by most standard libraries.
+//
+// IntroSort starts out as QuickSort, but tracks the recursion depth. Once the depth exceeds
+// 2*log2(n) the current subarray is sorted with heap sort instead, so inputs crafted to defeat
+// the median-of-three pivot can no longer push it into quadratic time. Subarrays shorter
+// than 12 elements are finished with insertion sort, as in QuickSort.
+//
+// Time Complexity: O(n log n) guaranteed, with QuickSort's speed on typical input
+// Space Complexity: O(n) for the result, O(log n) for the recursion stack
+// Stability: Not stable
+//
+// Parameters:
+//   - data: slice of any ordered type to be sorted
+//
+// Returns:
+//   - A new slice containing the elements sorted in ascending order
+//
+// Example:
+//
+//	numbers := []int{64, 34, 25, 12, 22, 11, 90}
+//	sorted := sort.IntroSort(numbers)
+//	// sorted: [11, 12, 22, 25, 34, 64, 90]
+func IntroSort[T cmp.Ordered](data []T) []T {
+	// Create a copy to avoid modifying the original slice
+	result := make([]T, len(data))
+	copy(result, data)
+
+	if len(result) > 1 {
+		introSortInPlace(result, 0, len(result)-1, 2*(bits.Len(uint(len(result)))-1))
+	}
+	return result
+}
+
+// introSortInPlace sorts arr[low..high] with quicksort, switching to heap sort once
+// depthLimit partitioning levels have been used up.
+func introSortInPlace[T cmp.Ordered](arr []T, low, high, depthLimit int) {
+	for high-low+1 >= insertionSortThreshold {
+		if depthLimit == 0 {
+			HeapSortInPlace(arr[low : high+1])
+			return
+		}
+		depthLimit--
+
+		medianOfThree(arr, low, high)
+		pivotIndex := partition(arr, low, high)
+
+		// Recurse into the smaller side and loop on the larger one to bound the stack depth
+		if pivotIndex-low < high-pivotIndex {
+			introSortInPlace(arr, low, pivotIndex-1, depthLimit)
+			low = pivotIndex + 1
+		} else {
+			introSortInPlace(arr, pivotIndex+1, high, depthLimit)
+			high = pivotIndex - 1
+		}
+	}
+	insertionSortInPlace(arr, low, high)
+}
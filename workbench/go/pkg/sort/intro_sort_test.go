@@ -0,0 +1,74 @@
+package sort
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntroSort(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	shuffled := make([]int, 2000)
+	for i := range shuffled {
+		shuffled[i] = random.Intn(500) - 250
+	}
+	ascending := make([]int, 2000)
+	for i := range ascending {
+		ascending[i] = i
+	}
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+	organPipe := append(slices.Clone(ascending[:1000]), descending[1000:]...)
+
+	tests := []struct {
+		name string
+		data []int
+	}{
+		{"empty", []int{}},
+		{"single", []int{1}},
+		{"small", []int{3, 1, 2}},
+		{"shuffled", shuffled},
+		{"ascending", ascending},
+		{"descending", descending},
+		{"organ pipe", organPipe},
+		// Lomuto partitioning degrades to O(n²) on equal keys; the depth limit caps it.
+		{"all equal", make([]int, 20000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.data)
+			result := IntroSort(tt.data)
+
+			want := slices.Clone(tt.data)
+			slices.Sort(want)
+			assert.Equal(t, want, result)
+			assert.Equal(t, original, tt.data, "input must not be modified")
+		})
+	}
+
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, IntroSort([]string{"cherry", "apple", "banana"}))
+}
+
+func TestIntroSortInPlace_HeapSortFallback(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	data := make([]float64, 300)
+	for i := range data {
+		data[i] = random.Float64()
+	}
+	want := slices.Clone(data)
+	slices.Sort(want)
+
+	// A depth limit of 0 sends the whole slice straight to heap sort.
+	introSortInPlace(data, 0, len(data)-1, 0)
+	assert.Equal(t, want, data)
+
+	// Falling back on an inner subarray must leave the rest of the slice untouched.
+	data = []float64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, -1, -2, -3, -4, -5, 100}
+	want = slices.Clone(data)
+	slices.Sort(want[2:14])
+	introSortInPlace(data, 2, 13, 0)
+	assert.Equal(t, want, data)
+}
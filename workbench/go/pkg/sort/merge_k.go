@@ -0,0 +1,64 @@
+package sort
+
+import (
+	"cmp"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/cmputil"
+	"github.com/haru-256/ctci-6th-edition/pkg/heap"
+)
+
+// cursor points at the next unmerged element of one input list in MergeK.
+type cursor[T cmp.Ordered] struct {
+	value T   // lists[list][elem], cached for comparisons
+	list  int // index of the input list
+	elem  int // index of the element within that list
+}
+
+// MergeK merges any number of slices, each sorted in ascending order, into one new
+// sorted slice. A min-heap holds the next unmerged element of every non-empty list,
+// so each output element costs one heap operation over at most k entries.
+// Equal elements keep the order of their lists (and their order within a list),
+// so the merge is stable. The inputs are not modified; the result is undefined if
+// an input is not sorted.
+//
+// Time Complexity: O(N log k) where N is the total number of elements and k the number of lists
+// Space Complexity: O(N + k)
+//
+// Example:
+//
+//	merged := sort.MergeK([]int{1, 4, 7}, []int{2, 5, 8}, []int{3, 6, 9})
+//	// merged: [1, 2, 3, 4, 5, 6, 7, 8, 9]
+func MergeK[T cmp.Ordered](lists ...[]T) []T {
+	total := 0
+	for _, list := range lists {
+		total += len(list)
+	}
+	merged := make([]T, 0, total)
+
+	h := heap.NewHeap(cursorCmp[T])
+	for i, list := range lists {
+		if len(list) > 0 {
+			_ = h.Insert(cursor[T]{value: list[0], list: i, elem: 0})
+		}
+	}
+
+	for h.Size() > 0 {
+		// The heap is non-empty, so Pop cannot fail.
+		next, _ := h.Pop()
+		merged = append(merged, next.value)
+
+		if elem := next.elem + 1; elem < len(lists[next.list]) {
+			_ = h.Insert(cursor[T]{value: lists[next.list][elem], list: next.list, elem: elem})
+		}
+	}
+	return merged
+}
+
+// cursorCmp orders cursors for a min-heap: the smaller value comes first,
+// and among equal values the cursor from the earlier list comes first.
+func cursorCmp[T cmp.Ordered](a, b *cursor[T]) int {
+	if c := cmputil.Min(&a.value, &b.value); c != 0 {
+		return c
+	}
+	return b.list - a.list
+}
@@ -0,0 +1,58 @@
+package sort
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeK(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lists    [][]int
+		expected []int
+	}{
+		{"no lists", nil, []int{}},
+		{"only empty lists", [][]int{{}, {}}, []int{}},
+		{"single list", [][]int{{1, 2, 3}}, []int{1, 2, 3}},
+		{"interleaved", [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}}, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"uneven lengths with empties", [][]int{{}, {5}, {1, 2, 3, 10}, {}, {4, 6}}, []int{1, 2, 3, 4, 5, 6, 10}},
+		{"duplicates", [][]int{{1, 3, 3}, {3, 3, 5}}, []int{1, 3, 3, 3, 3, 5}},
+		{"negatives", [][]int{{-5, 0}, {-10, 10}}, []int{-10, -5, 0, 10}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MergeK(tc.lists...))
+		})
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, MergeK([]string{"a", "d"}, []string{"b", "c"}))
+}
+
+func TestMergeK_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var lists [][]int
+	var all []int
+	for i := 0; i < 10; i++ {
+		list := make([]int, r.Intn(50))
+		for j := range list {
+			list[j] = r.Intn(100)
+		}
+		list = MergeSort(list)
+		lists = append(lists, list)
+		all = append(all, list...)
+	}
+
+	merged := MergeK(lists...)
+	assert.Equal(t, MergeSort(all), merged)
+	assert.True(t, IsSorted(merged))
+}
+
+func TestMergeK_DoesNotModifyInput(t *testing.T) {
+	a := []int{1, 3}
+	b := []int{2, 4}
+	_ = MergeK(a, b)
+	assert.Equal(t, []int{1, 3}, a)
+	assert.Equal(t, []int{2, 4}, b)
+}
@@ -0,0 +1,100 @@
+package sort
+
+import (
+	"cmp"
+)
+
+// MergeSort sorts a slice using the top-down merge sort algorithm.
+//
+// MergeSort provides guaranteed O(n log n) time complexity and is stable,
+// meaning equal elements keep their relative order from the input.
+//
+// The algorithm works by:
+// 1. Splitting the array into two halves
+// 2. Recursively sorting each half
+// 3. Merging the two sorted halves, taking from the left half on ties
+//
+// Time Complexity: O(n log n) - guaranteed for all cases
+// Space Complexity: O(n) for the result and a single auxiliary buffer
+// Stability: Stable
+//
+// Parameters:
+//   - arr: slice of any ordered type to be sorted
+//
+// Returns:
+//   - A new slice containing the elements sorted in ascending order
+//
+// Example:
+//
+//	numbers := []int{64, 34, 25, 12, 22, 11, 90}
+//	sorted := sort.MergeSort(numbers)
+//	// sorted: [11, 12, 22, 25, 34, 64, 90]
+func MergeSort[T cmp.Ordered](arr []T) []T {
+	return MergeSortFunc(arr, cmp.Less[T])
+}
+
+// MergeSortFunc sorts a slice with merge sort, ordering elements by the less function.
+//
+// less must report whether a should sort before b and describe a strict weak ordering.
+// Like MergeSort it is stable, so elements for which neither less(a, b) nor less(b, a)
+// holds keep their relative order from the input.
+//
+// Time Complexity: O(n log n) - guaranteed for all cases
+// Space Complexity: O(n) for the result and a single auxiliary buffer
+// Stability: Stable
+//
+// Example:
+//
+//	type Person struct {
+//	    Name string
+//	    Age  int
+//	}
+//	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}}
+//	byAge := sort.MergeSortFunc(people, func(a, b Person) bool { return a.Age < b.Age })
+//	// byAge: [{Bob 25} {Alice 30} {Carol 30}]
+func MergeSortFunc[T any](arr []T, less func(a, b T) bool) []T {
+	// Create a copy to avoid modifying the original slice
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	buf := make([]T, len(result))
+	mergeSortInPlace(result, buf, less)
+	return result
+}
+
+// mergeSortInPlace recursively sorts arr, using buf (of the same length) as scratch space.
+func mergeSortInPlace[T any](arr, buf []T, less func(a, b T) bool) {
+	if len(arr) <= 1 {
+		return
+	}
+
+	mid := len(arr) / 2
+	mergeSortInPlace(arr[:mid], buf[:mid], less)
+	mergeSortInPlace(arr[mid:], buf[mid:], less)
+
+	// Skip the merge when the halves are already in order
+	if !less(arr[mid], arr[mid-1]) {
+		return
+	}
+	merge(arr, buf, mid, less)
+}
+
+// merge combines the sorted runs arr[:mid] and arr[mid:] into arr.
+// Ties are resolved in favour of the left run, which keeps the sort stable.
+func merge[T any](arr, buf []T, mid int, less func(a, b T) bool) {
+	copy(buf, arr)
+
+	i, j, k := 0, mid, 0
+	for i < mid && j < len(buf) {
+		if less(buf[j], buf[i]) {
+			arr[k] = buf[j]
+			j++
+		} else {
+			arr[k] = buf[i]
+			i++
+		}
+		k++
+	}
+	k += copy(arr[k:], buf[i:mid])
+	copy(arr[k:], buf[j:])
+}
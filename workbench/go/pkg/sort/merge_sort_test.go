@@ -0,0 +1,96 @@
+package sort
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSort_EmptySlice(t *testing.T) {
+	var empty []int
+	result := MergeSort(empty)
+	assert.Empty(t, result, "MergeSort should handle empty slices")
+}
+
+func TestMergeSort_SingleElement(t *testing.T) {
+	result := MergeSort([]int{42})
+	assert.Equal(t, []int{42}, result, "MergeSort should handle single element")
+}
+
+func TestMergeSort_WithDuplicates(t *testing.T) {
+	result := MergeSort([]int{3, 1, 4, 1, 5, 9, 2, 6, 5})
+	expected := []int{1, 1, 2, 3, 4, 5, 5, 6, 9}
+	assert.Equal(t, expected, result, "MergeSort should handle duplicates correctly")
+}
+
+func TestMergeSort_Strings(t *testing.T) {
+	result := MergeSort([]string{"banana", "apple", "cherry", "date"})
+	expected := []string{"apple", "banana", "cherry", "date"}
+	assert.Equal(t, expected, result, "MergeSort should work with strings")
+}
+
+func TestMergeSort_Stable(t *testing.T) {
+	// -0.0 and +0.0 compare equal, so stability is observable through the sign bit
+	negZero := math.Copysign(0, -1)
+	data := []float64{1, 0, negZero, -1, 0}
+
+	result := MergeSort(data)
+	assert.Equal(t, []float64{-1, 0, 0, 0, 1}, result)
+	assert.False(t, math.Signbit(result[1]), "first zero should be the original +0")
+	assert.True(t, math.Signbit(result[2]), "-0 should keep its position between the +0 values")
+	assert.False(t, math.Signbit(result[3]), "last zero should be the original +0")
+}
+
+func TestMergeSort_DoesNotModifyOriginal(t *testing.T) {
+	original := []int{3, 1, 4, 1, 5}
+	originalCopy := make([]int, len(original))
+	copy(originalCopy, original)
+
+	result := MergeSort(original)
+	assert.Equal(t, originalCopy, original, "MergeSort should not modify original slice")
+	assert.Equal(t, []int{1, 1, 3, 4, 5}, result)
+}
+
+func TestMergeSort_CorrectnessAgainstStandardLibrary(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		size := rand.Intn(100) + 1 // 1 to 100 elements
+		data := make([]int, size)
+		for j := 0; j < size; j++ {
+			data[j] = rand.Intn(1000)
+		}
+
+		expected := make([]int, len(data))
+		copy(expected, data)
+		sort.Ints(expected)
+
+		assert.Equal(t, expected, MergeSort(data), "MergeSort result should match standard library sort for dataset %d", i)
+	}
+}
+
+// Benchmark tests
+func BenchmarkMergeSort_Random1000(b *testing.B) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = rand.Intn(10000)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MergeSort(data)
+	}
+}
+
+func BenchmarkMergeSort_Sorted1000(b *testing.B) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MergeSort(data)
+	}
+}
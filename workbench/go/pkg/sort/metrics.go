@@ -0,0 +1,214 @@
+package sort
+
+import (
+	"cmp"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/heap"
+)
+
+// SortMetrics records the work done by an instrumented sort, so that algorithms can be
+// compared empirically rather than only by wall-clock time.
+type SortMetrics struct {
+	// Comparisons is the number of times two elements were compared
+	Comparisons int
+	// Swaps is the number of times two elements exchanged places
+	Swaps int
+	// Moves is the number of single element writes that are not part of a swap,
+	// such as the shifts of insertion sort or the copies made while merging
+	Moves int
+	// MaxDepth is the deepest level of recursion reached, where the top-level call is 1.
+	// It is 0 for an empty input and for iterative algorithms.
+	MaxDepth int
+}
+
+// recorder counts the operations of an instrumented sort on a single slice.
+type recorder[T cmp.Ordered] struct {
+	arr     []T
+	metrics SortMetrics
+}
+
+// less reports whether a < b, counting one comparison.
+func (r *recorder[T]) less(a, b T) bool {
+	r.metrics.Comparisons++
+	return a < b
+}
+
+// swap exchanges arr[i] and arr[j], counting one swap.
+func (r *recorder[T]) swap(i, j int) {
+	r.metrics.Swaps++
+	r.arr[i], r.arr[j] = r.arr[j], r.arr[i]
+}
+
+// enter records that the recursion reached depth.
+func (r *recorder[T]) enter(depth int) {
+	r.metrics.MaxDepth = max(r.metrics.MaxDepth, depth)
+}
+
+// QuickSortInstrumented sorts a copy of data exactly like QuickSort (median-of-three
+// pivot, Lomuto partition, insertion sort below 12 elements) and reports the
+// comparisons, swaps, insertion sort shifts and recursion depth it took.
+//
+// It is meant for studying the algorithm, e.g. confirming that sorted input stays at
+// O(log n) depth thanks to the median-of-three pivot; use QuickSort for real work.
+//
+// Example:
+//
+//	sorted, metrics := sort.QuickSortInstrumented([]int{5, 2, 9, 1})
+//	fmt.Println(sorted, metrics.Comparisons, metrics.MaxDepth)
+func QuickSortInstrumented[T cmp.Ordered](data []T) ([]T, SortMetrics) {
+	result := make([]T, len(data))
+	copy(result, data)
+
+	r := &recorder[T]{arr: result}
+	if len(result) > 0 {
+		r.quickSort(0, len(result)-1, 1)
+	}
+	return result, r.metrics
+}
+
+// quickSort mirrors quickSortInPlace on arr[low..high] while counting operations.
+func (r *recorder[T]) quickSort(low, high, depth int) {
+	r.enter(depth)
+	if high-low+1 < insertionSortThreshold {
+		r.insertionSort(low, high)
+		return
+	}
+
+	// median of three, as in medianOfThree
+	mid := low + (high-low)/2
+	if r.less(r.arr[mid], r.arr[low]) {
+		r.swap(mid, low)
+	}
+	if r.less(r.arr[high], r.arr[low]) {
+		r.swap(high, low)
+	}
+	if r.less(r.arr[high], r.arr[mid]) {
+		r.swap(high, mid)
+	}
+	r.swap(mid, high)
+
+	// Lomuto partition, as in partition
+	pivot := r.arr[high]
+	i := low - 1
+	for j := low; j < high; j++ {
+		if !r.less(pivot, r.arr[j]) {
+			i++
+			r.swap(i, j)
+		}
+	}
+	r.swap(i+1, high)
+
+	r.quickSort(low, i, depth+1)
+	r.quickSort(i+2, high, depth+1)
+}
+
+// insertionSort mirrors insertionSortInPlace on arr[low..high] while counting operations.
+func (r *recorder[T]) insertionSort(low, high int) {
+	for i := low + 1; i <= high; i++ {
+		value := r.arr[i]
+		j := i - 1
+		for j >= low && r.less(value, r.arr[j]) {
+			r.arr[j+1] = r.arr[j]
+			r.metrics.Moves++
+			j--
+		}
+		if j+1 != i {
+			r.arr[j+1] = value
+			r.metrics.Moves++
+		}
+	}
+}
+
+// HeapSortInstrumented sorts a copy of data exactly like HeapSortInPlace and reports the
+// comparisons and swaps it took. Heap sort is iterative, so MaxDepth is always 0.
+//
+// Example:
+//
+//	sorted, metrics := sort.HeapSortInstrumented([]int{5, 2, 9, 1})
+//	fmt.Println(sorted, metrics.Comparisons, metrics.Swaps)
+func HeapSortInstrumented[T cmp.Ordered](data []T) ([]T, SortMetrics) {
+	result := make([]T, len(data))
+	copy(result, data)
+
+	r := &recorder[T]{arr: result}
+	n := len(result)
+	for i := heap.Parent(n - 1); i >= 0; i-- {
+		r.siftDown(i, n)
+	}
+	for end := n - 1; end > 0; end-- {
+		r.swap(0, end)
+		r.siftDown(0, end)
+	}
+	return result, r.metrics
+}
+
+// siftDown mirrors the package-level siftDown while counting operations.
+func (r *recorder[T]) siftDown(i, size int) {
+	for {
+		largest := i
+		left, right := heap.Left(i), heap.Right(i)
+		if left < size && r.less(r.arr[largest], r.arr[left]) {
+			largest = left
+		}
+		if right < size && r.less(r.arr[largest], r.arr[right]) {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		r.swap(i, largest)
+		i = largest
+	}
+}
+
+// MergeSortInstrumented sorts a copy of data exactly like MergeSort and reports the
+// comparisons, element copies made while merging (as Moves) and recursion depth it took.
+// Merge sort never swaps, so Swaps is always 0.
+//
+// Example:
+//
+//	sorted, metrics := sort.MergeSortInstrumented([]int{5, 2, 9, 1})
+//	fmt.Println(sorted, metrics.Comparisons, metrics.MaxDepth)
+func MergeSortInstrumented[T cmp.Ordered](data []T) ([]T, SortMetrics) {
+	result := make([]T, len(data))
+	copy(result, data)
+
+	r := &recorder[T]{arr: result}
+	if len(result) > 0 {
+		r.mergeSort(result, make([]T, len(result)), 1)
+	}
+	return result, r.metrics
+}
+
+// mergeSort mirrors mergeSortInPlace on arr while counting operations.
+func (r *recorder[T]) mergeSort(arr, buf []T, depth int) {
+	r.enter(depth)
+	if len(arr) <= 1 {
+		return
+	}
+
+	mid := len(arr) / 2
+	r.mergeSort(arr[:mid], buf[:mid], depth+1)
+	r.mergeSort(arr[mid:], buf[mid:], depth+1)
+
+	if !r.less(arr[mid], arr[mid-1]) {
+		return
+	}
+
+	copy(buf, arr)
+	r.metrics.Moves += len(arr)
+	i, j, k := 0, mid, 0
+	for i < mid && j < len(buf) {
+		if r.less(buf[j], buf[i]) {
+			arr[k] = buf[j]
+			j++
+		} else {
+			arr[k] = buf[i]
+			i++
+		}
+		k++
+	}
+	k += copy(arr[k:], buf[i:mid])
+	copy(arr[k:], buf[j:])
+	r.metrics.Moves += len(arr)
+}
@@ -0,0 +1,94 @@
+package sort
+
+import (
+	"math/bits"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstrumentedSorts(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	shuffled := make([]int, 500)
+	for i := range shuffled {
+		shuffled[i] = random.Intn(1000)
+	}
+	ascending := make([]int, 500)
+	for i := range ascending {
+		ascending[i] = i
+	}
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+
+	sorts := []struct {
+		name string
+		fn   func([]int) ([]int, SortMetrics)
+	}{
+		{"QuickSort", QuickSortInstrumented[int]},
+		{"HeapSort", HeapSortInstrumented[int]},
+		{"MergeSort", MergeSortInstrumented[int]},
+	}
+	inputs := []struct {
+		name string
+		data []int
+	}{
+		{"empty", []int{}},
+		{"single", []int{42}},
+		{"shuffled", shuffled},
+		{"ascending", ascending},
+		{"descending", descending},
+	}
+
+	for _, s := range sorts {
+		for _, in := range inputs {
+			t.Run(s.name+"/"+in.name, func(t *testing.T) {
+				original := slices.Clone(in.data)
+				sorted, metrics := s.fn(in.data)
+
+				want := slices.Clone(in.data)
+				slices.Sort(want)
+				assert.Equal(t, want, sorted)
+				assert.Equal(t, original, in.data, "input must not be modified")
+				if len(in.data) <= 1 {
+					assert.Zero(t, metrics.Comparisons)
+					assert.Zero(t, metrics.Swaps)
+					assert.Zero(t, metrics.Moves)
+				} else {
+					assert.Positive(t, metrics.Comparisons)
+				}
+			})
+		}
+	}
+}
+
+func TestQuickSortInstrumented_Metrics(t *testing.T) {
+	_, metrics := QuickSortInstrumented([]int{2, 1})
+	assert.Equal(t, SortMetrics{Comparisons: 1, Moves: 2, MaxDepth: 1}, metrics)
+
+	// The median-of-three pivot keeps sorted input at logarithmic depth.
+	sorted := make([]int, 4096)
+	for i := range sorted {
+		sorted[i] = i
+	}
+	_, metrics = QuickSortInstrumented(sorted)
+	assert.LessOrEqual(t, metrics.MaxDepth, 2*bits.Len(uint(len(sorted))))
+}
+
+func TestHeapSortInstrumented_Metrics(t *testing.T) {
+	data := []int{5, 3, 8, 1, 9, 2, 7}
+	_, metrics := HeapSortInstrumented(data)
+	assert.GreaterOrEqual(t, metrics.Swaps, len(data)-1, "every extraction swaps the root to the end")
+	assert.Zero(t, metrics.Moves)
+	assert.Zero(t, metrics.MaxDepth)
+}
+
+func TestMergeSortInstrumented_Metrics(t *testing.T) {
+	// Already sorted halves skip the merge after a single comparison.
+	_, metrics := MergeSortInstrumented([]int{1, 2, 3, 4, 5, 6, 7, 8})
+	assert.Equal(t, SortMetrics{Comparisons: 7, MaxDepth: 4}, metrics)
+
+	_, metrics = MergeSortInstrumented([]int{2, 1})
+	assert.Equal(t, SortMetrics{Comparisons: 2, Moves: 4, MaxDepth: 2}, metrics)
+}
@@ -0,0 +1,43 @@
+package sort
+
+import (
+	"cmp"
+)
+
+// IsSorted reports whether the slice is sorted in ascending order.
+// It stops at the first pair of adjacent elements that is out of order,
+// so unsorted input is usually rejected without scanning the whole slice.
+// Empty and single-element slices are sorted.
+//
+// Time Complexity: O(n) worst case
+// Space Complexity: O(1)
+//
+// Example:
+//
+//	sort.IsSorted([]int{1, 2, 2, 5}) // true
+//	sort.IsSorted([]int{1, 3, 2})    // false
+func IsSorted[T cmp.Ordered](arr []T) bool {
+	for i := 1; i < len(arr); i++ {
+		if arr[i] < arr[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// SortDescending returns a new slice containing the elements sorted in descending order.
+// It runs MergeSortFunc with an inverted comparison, so it is stable and
+// guaranteed O(n log n), and it does not modify the input slice.
+//
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+// Stability: Stable
+//
+// Example:
+//
+//	numbers := []int{64, 34, 25, 12, 22, 11, 90}
+//	sorted := sort.SortDescending(numbers)
+//	// sorted: [90, 64, 34, 25, 22, 12, 11]
+func SortDescending[T cmp.Ordered](arr []T) []T {
+	return MergeSortFunc(arr, func(a, b T) bool { return cmp.Less(b, a) })
+}
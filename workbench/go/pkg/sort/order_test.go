@@ -0,0 +1,71 @@
+package sort
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSorted(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []int
+		expected bool
+	}{
+		{"empty", []int{}, true},
+		{"single", []int{42}, true},
+		{"ascending", []int{1, 2, 3, 4, 5}, true},
+		{"with duplicates", []int{1, 1, 2, 2, 3}, true},
+		{"descending", []int{5, 4, 3, 2, 1}, false},
+		{"last pair out of order", []int{1, 2, 3, 5, 4}, false},
+		{"first pair out of order", []int{2, 1, 3, 4, 5}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsSorted(tc.data))
+		})
+	}
+
+	assert.True(t, IsSorted([]string{"apple", "banana", "cherry"}))
+	assert.False(t, IsSorted([]string{"banana", "apple"}))
+}
+
+func TestSortDescending(t *testing.T) {
+	original := []int{3, 1, 4, 1, 5, 9, 2, 6, 5}
+	originalCopy := make([]int, len(original))
+	copy(originalCopy, original)
+
+	result := SortDescending(original)
+	assert.Equal(t, []int{9, 6, 5, 5, 4, 3, 2, 1, 1}, result)
+	assert.Equal(t, originalCopy, original, "SortDescending should not modify original slice")
+
+	assert.Empty(t, SortDescending([]int{}))
+	assert.Equal(t, []string{"cherry", "banana", "apple"}, SortDescending([]string{"banana", "cherry", "apple"}))
+
+	for i := 0; i < 100; i++ {
+		data := make([]int, rand.Intn(100)+1)
+		for j := range data {
+			data[j] = rand.Intn(1000)
+		}
+
+		expected := make([]int, len(data))
+		copy(expected, data)
+		sort.Sort(sort.Reverse(sort.IntSlice(expected)))
+
+		assert.Equal(t, expected, SortDescending(data), "dataset %d", i)
+	}
+}
+
+func TestSortedOutputsAreSorted(t *testing.T) {
+	data := []int{64, 34, 25, 12, 22, 11, 90}
+	heapResult, err := HeapSort(data)
+	assert.NoError(t, err)
+
+	assert.False(t, IsSorted(data))
+	assert.True(t, IsSorted(heapResult))
+	assert.True(t, IsSorted(QuickSort(data)))
+	assert.True(t, IsSorted(MergeSort(data)))
+	assert.False(t, IsSorted(SortDescending(data)))
+}
@@ -7,13 +7,15 @@ import (
 // QuickSort sorts a slice using the quicksort algorithm.
 //
 // QuickSort provides O(n log n) average time complexity with O(log n) space complexity
-// for the recursion stack. In the worst case (already sorted data with poor pivot selection),
-// it can degrade to O(n²) time complexity.
+// for the recursion stack. The pivot is the median of the first, middle and last elements,
+// so already sorted or reverse sorted data no longer triggers the O(n²) worst case,
+// although specially crafted inputs still can.
 //
 // The algorithm works by:
-// 1. Choosing a pivot element (last element in this implementation)
+// 1. Choosing a pivot element (median of three in this implementation)
 // 2. Partitioning the array so elements ≤ pivot are on the left, > pivot on the right
 // 3. Recursively sorting the left and right subarrays
+// 4. Finishing subarrays shorter than 12 elements with insertion sort
 //
 // Time Complexity: O(n log n) average case, O(n²) worst case
 // Space Complexity: O(log n) for recursion stack
@@ -43,15 +45,44 @@ func QuickSort[T cmp.Ordered](arr []T) []T {
 	return result
 }
 
+// QuickSortInPlace sorts the caller's slice in ascending order using quicksort.
+//
+// Unlike QuickSort it does not allocate a result: the slice passed in is rearranged
+// directly and nothing is returned, so the original order is lost. Use it when the
+// extra copy made by QuickSort would be too costly for large inputs.
+//
+// Time Complexity: O(n log n) average case, O(n²) worst case
+// Space Complexity: O(log n) for recursion stack
+// Stability: Not stable
+//
+// Example:
+//
+//	numbers := []int{64, 34, 25, 12, 22, 11, 90}
+//	sort.QuickSortInPlace(numbers)
+//	// numbers: [11, 12, 22, 25, 34, 64, 90]
+func QuickSortInPlace[T cmp.Ordered](arr []T) {
+	quickSortInPlace(arr, 0, len(arr)-1)
+}
+
 // quickSortInPlace performs the actual quicksort algorithm in-place on a subarray.
 // This is the internal recursive function that does the heavy lifting.
+// Subarrays shorter than insertionSortThreshold are handed to insertion sort,
+// which avoids the recursion overhead where partitioning no longer pays off.
 //
 // Parameters:
 //   - arr: the array to sort
 //   - low: the starting index of the subarray to sort
 //   - high: the ending index of the subarray to sort
 func quickSortInPlace[T cmp.Ordered](arr []T, low, high int) {
+	if high-low+1 < insertionSortThreshold {
+		insertionSortInPlace(arr, low, high)
+		return
+	}
+
 	if low < high {
+		// Move the median of three to the end so partition uses it as the pivot
+		medianOfThree(arr, low, high)
+
 		// Partition the array and get the pivot index
 		pivotIndex := partition(arr, low, high)
 
@@ -61,6 +92,93 @@ func quickSortInPlace[T cmp.Ordered](arr []T, low, high int) {
 	}
 }
 
+// QuickSort3Way sorts a slice using quicksort with three-way (Dutch national flag) partitioning.
+//
+// Each partitioning pass splits the subarray into elements less than, equal to and greater
+// than the pivot, and only the "less" and "greater" regions are sorted recursively.
+// Runs of equal elements are therefore placed in a single pass, which makes inputs with
+// many duplicate keys sort in close to linear time where QuickSort would keep re-partitioning them.
+//
+// Time Complexity: O(n log n) average case, O(n) when there are few distinct keys
+// Space Complexity: O(log n) for recursion stack
+// Stability: Not stable
+//
+// Parameters:
+//   - arr: slice of any ordered type to be sorted
+//
+// Returns:
+//   - A new slice containing the elements sorted in ascending order
+//
+// Example:
+//
+//	grades := []string{"B", "A", "C", "A", "B", "A"}
+//	sorted := sort.QuickSort3Way(grades)
+//	// sorted: ["A", "A", "A", "B", "B", "C"]
+func QuickSort3Way[T cmp.Ordered](arr []T) []T {
+	// Create a copy to avoid modifying the original slice
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	quickSort3WayInPlace(result, 0, len(result)-1)
+	return result
+}
+
+// quickSort3WayInPlace sorts the subarray arr[low..high] in place with three-way partitioning.
+func quickSort3WayInPlace[T cmp.Ordered](arr []T, low, high int) {
+	if high-low+1 < insertionSortThreshold {
+		insertionSortInPlace(arr, low, high)
+		return
+	}
+
+	medianOfThree(arr, low, high)
+	lt, gt := partition3Way(arr, low, high)
+
+	quickSort3WayInPlace(arr, low, lt-1)
+	quickSort3WayInPlace(arr, gt+1, high)
+}
+
+// partition3Way rearranges arr[low..high] around the pivot arr[high] into three regions:
+// arr[low..lt-1] < pivot, arr[lt..gt] == pivot and arr[gt+1..high] > pivot.
+// It returns lt and gt, the bounds of the region equal to the pivot.
+func partition3Way[T cmp.Ordered](arr []T, low, high int) (int, int) {
+	pivot := arr[high]
+	lt, i, gt := low, low, high
+
+	for i <= gt {
+		switch {
+		case arr[i] < pivot:
+			arr[lt], arr[i] = arr[i], arr[lt]
+			lt++
+			i++
+		case arr[i] > pivot:
+			arr[i], arr[gt] = arr[gt], arr[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// medianOfThree orders arr[low], arr[mid] and arr[high], then swaps the median into
+// arr[high] where partition expects the pivot.
+// Using the median of three samples keeps the split balanced on sorted and reverse
+// sorted input, which are the worst cases for a plain last-element pivot.
+func medianOfThree[T cmp.Ordered](arr []T, low, high int) {
+	mid := low + (high-low)/2
+	if arr[mid] < arr[low] {
+		arr[mid], arr[low] = arr[low], arr[mid]
+	}
+	if arr[high] < arr[low] {
+		arr[high], arr[low] = arr[low], arr[high]
+	}
+	if arr[high] < arr[mid] {
+		arr[high], arr[mid] = arr[mid], arr[high]
+	}
+	// arr[low] <= arr[mid] <= arr[high]; move the median into the pivot slot
+	arr[mid], arr[high] = arr[high], arr[mid]
+}
+
 // Partition rearranges the subarray arr[low..high] so that elements ≤ pivot
 // are on the left and elements > pivot are on the right.
 //
@@ -176,6 +176,90 @@ func TestPartition_AllEqual(t *testing.T) {
 	}
 }
 
+func TestMedianOfThree(t *testing.T) {
+	testCases := []struct {
+		name string
+		arr  []int
+	}{
+		{"sorted", []int{1, 2, 3}},
+		{"reverse", []int{3, 2, 1}},
+		{"median first", []int{2, 3, 1}},
+		{"median last", []int{1, 3, 2}},
+		{"all equal", []int{2, 2, 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			medianOfThree(tc.arr, 0, len(tc.arr)-1)
+			assert.Equal(t, 2, tc.arr[len(tc.arr)-1], "median should be moved into the pivot slot")
+		})
+	}
+}
+
+func TestQuickSort3Way(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []int
+		expected []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single", []int{42}, []int{42}},
+		{"all equal", []int{5, 5, 5, 5, 5}, []int{5, 5, 5, 5, 5}},
+		{"with duplicates", []int{3, 1, 4, 1, 5, 9, 2, 6, 5}, []int{1, 1, 2, 3, 4, 5, 5, 6, 9}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := make([]int, len(tc.data))
+			copy(original, tc.data)
+
+			assert.Equal(t, tc.expected, QuickSort3Way(tc.data))
+			assert.Equal(t, original, tc.data, "QuickSort3Way should not modify original slice")
+		})
+	}
+
+	t.Run("against standard library", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			size := rand.Intn(200) + 1
+			data := make([]int, size)
+			for j := range data {
+				data[j] = rand.Intn(10) // few distinct keys
+			}
+
+			expected := make([]int, len(data))
+			copy(expected, data)
+			sort.Ints(expected)
+
+			assert.Equal(t, expected, QuickSort3Way(data), "dataset %d", i)
+		}
+	})
+}
+
+func TestPartition3Way(t *testing.T) {
+	arr := []int{3, 5, 1, 3, 7, 3, 2, 3}
+	lt, gt := partition3Way(arr, 0, len(arr)-1)
+
+	for i := 0; i < lt; i++ {
+		assert.Less(t, arr[i], 3)
+	}
+	for i := lt; i <= gt; i++ {
+		assert.Equal(t, 3, arr[i])
+	}
+	for i := gt + 1; i < len(arr); i++ {
+		assert.Greater(t, arr[i], 3)
+	}
+	assert.Equal(t, 4, gt-lt+1, "all copies of the pivot should be grouped together")
+}
+
+// lastPivotQuickSort is the plain last-element-pivot quicksort, kept as a baseline
+// for comparing pivot selection strategies in benchmarks.
+func lastPivotQuickSort(arr []int, low, high int) {
+	if low < high {
+		pivotIndex := Partition(arr, low, high)
+		lastPivotQuickSort(arr, low, pivotIndex-1)
+		lastPivotQuickSort(arr, pivotIndex+1, high)
+	}
+}
+
 // Benchmark tests
 func BenchmarkQuickSort_Random100(b *testing.B) {
 	data := make([]int, 100)
@@ -253,3 +337,51 @@ func BenchmarkPartition_Random1000(b *testing.B) {
 		Partition(testData, 0, len(testData)-1)
 	}
 }
+
+func BenchmarkQuickSort_PivotSelection(b *testing.B) {
+	sorted := make([]int, 1000)
+	reverse := make([]int, 1000)
+	for i := range sorted {
+		sorted[i] = i
+		reverse[i] = len(reverse) - i
+	}
+
+	for _, input := range []struct {
+		name string
+		data []int
+	}{
+		{"Sorted1000", sorted},
+		{"Reverse1000", reverse},
+	} {
+		b.Run("LastPivot/"+input.name, func(b *testing.B) {
+			testData := make([]int, len(input.data))
+			for i := 0; i < b.N; i++ {
+				copy(testData, input.data)
+				lastPivotQuickSort(testData, 0, len(testData)-1)
+			}
+		})
+		b.Run("MedianOfThree/"+input.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				QuickSort(input.data)
+			}
+		})
+	}
+}
+
+func BenchmarkQuickSort_MostlyDuplicates10000(b *testing.B) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = rand.Intn(4)
+	}
+
+	b.Run("QuickSort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			QuickSort(data)
+		}
+	})
+	b.Run("QuickSort3Way", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			QuickSort3Way(data)
+		}
+	})
+}
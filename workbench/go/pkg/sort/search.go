@@ -0,0 +1,72 @@
+package sort
+
+import (
+	"cmp"
+)
+
+// BinarySearch looks for target in a slice sorted in ascending order.
+// It returns the index of the first element equal to target and true if one exists;
+// otherwise it returns the index where target would be inserted to keep the slice
+// sorted, and false. The result is undefined if the slice is not sorted.
+//
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+//
+// Example:
+//
+//	data := []int{10, 20, 20, 30}
+//	sort.BinarySearch(data, 20) // 1, true
+//	sort.BinarySearch(data, 25) // 3, false
+func BinarySearch[T cmp.Ordered](data []T, target T) (int, bool) {
+	i := LowerBound(data, target)
+	return i, i < len(data) && data[i] == target
+}
+
+// LowerBound returns the index of the first element of a sorted slice that is
+// not less than target (that is, >= target), or len(data) if there is none.
+// It is the leftmost position where target can be inserted while keeping the slice sorted.
+// The result is undefined if the slice is not sorted in ascending order.
+//
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+//
+// Example:
+//
+//	sort.LowerBound([]int{10, 20, 20, 30}, 20) // 1
+func LowerBound[T cmp.Ordered](data []T, target T) int {
+	low, high := 0, len(data)
+	for low < high {
+		mid := int(uint(low+high) >> 1) // avoids overflow
+		if data[mid] < target {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// UpperBound returns the index of the first element of a sorted slice that is
+// greater than target, or len(data) if there is none.
+// It is the rightmost position where target can be inserted while keeping the slice sorted,
+// so data[LowerBound(data, x):UpperBound(data, x)] holds every element equal to x.
+// The result is undefined if the slice is not sorted in ascending order.
+//
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+//
+// Example:
+//
+//	sort.UpperBound([]int{10, 20, 20, 30}, 20) // 3
+func UpperBound[T cmp.Ordered](data []T, target T) int {
+	low, high := 0, len(data)
+	for low < high {
+		mid := int(uint(low+high) >> 1) // avoids overflow
+		if data[mid] <= target {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
@@ -0,0 +1,67 @@
+package sort
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinarySearch(t *testing.T) {
+	data := []int{10, 20, 20, 20, 30, 40}
+	testCases := []struct {
+		name      string
+		data      []int
+		target    int
+		wantIndex int
+		wantFound bool
+		wantLower int
+		wantUpper int
+	}{
+		{"empty", []int{}, 5, 0, false, 0, 0},
+		{"before all", data, 5, 0, false, 0, 0},
+		{"first", data, 10, 0, true, 0, 1},
+		{"duplicates", data, 20, 1, true, 1, 4},
+		{"between", data, 25, 4, false, 4, 4},
+		{"last", data, 40, 5, true, 5, 6},
+		{"after all", data, 50, 6, false, 6, 6},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			index, found := BinarySearch(tc.data, tc.target)
+			assert.Equal(t, tc.wantIndex, index)
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.wantLower, LowerBound(tc.data, tc.target))
+			assert.Equal(t, tc.wantUpper, UpperBound(tc.data, tc.target))
+		})
+	}
+
+	index, found := BinarySearch([]string{"apple", "banana", "cherry"}, "banana")
+	assert.Equal(t, 1, index)
+	assert.True(t, found)
+}
+
+func TestBinarySearch_MatchesStdlib(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := make([]int, 200)
+	for i := range data {
+		data[i] = r.Intn(50)
+	}
+	data = MergeSort(data)
+
+	for target := -1; target <= 51; target++ {
+		wantIndex, wantFound := slices.BinarySearch(data, target)
+		index, found := BinarySearch(data, target)
+		assert.Equal(t, wantIndex, index, "target %d", target)
+		assert.Equal(t, wantFound, found, "target %d", target)
+
+		upper := UpperBound(data, target)
+		for _, v := range data[LowerBound(data, target):upper] {
+			assert.Equal(t, target, v)
+		}
+		if upper < len(data) {
+			assert.Greater(t, data[upper], target)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package sort
+
+import (
+	"cmp"
+	"errors"
+)
+
+// ErrorIndexOutOfRange is returned when a requested position lies outside the slice.
+var ErrorIndexOutOfRange = errors.New("index out of range")
+
+// TopK returns the k largest elements of a slice, sorted in descending order.
+//
+// Instead of sorting the whole slice, TopK uses QuickSelect to move the k largest
+// elements to the end of a copy in expected O(n) time and then sorts only those k elements.
+// If k <= 0 it returns an empty slice; if k >= len(arr) it returns every element
+// in descending order. The input slice is not modified.
+//
+// Time Complexity: O(n + k log k) expected, O(n² + k log k) worst case
+// Space Complexity: O(n) for the working copy
+//
+// Example:
+//
+//	scores := []int{50, 90, 10, 70, 30, 80}
+//	top := sort.TopK(scores, 3)
+//	// top: [90, 80, 70]
+func TopK[T cmp.Ordered](arr []T, k int) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	// Create a copy to avoid modifying the original slice
+	work := make([]T, len(arr))
+	copy(work, arr)
+
+	k = min(k, len(work))
+	if k < len(work) {
+		quickSelect(work, len(work)-k)
+	}
+
+	top := work[len(work)-k:]
+	quickSortInPlace(top, 0, len(top)-1)
+	result := make([]T, k)
+	for i := range result {
+		result[i] = top[len(top)-1-i]
+	}
+	return result
+}
+
+// NthElement returns the element that would be at index n if the slice were sorted
+// in ascending order, using QuickSelect in expected O(n) time.
+// It returns ErrorIndexOutOfRange if n is not a valid index.
+// The input slice is not modified.
+//
+// Time Complexity: O(n) expected, O(n²) worst case
+// Space Complexity: O(n) for the working copy
+//
+// Example:
+//
+//	numbers := []int{64, 34, 25, 12, 22, 11, 90}
+//	median, _ := sort.NthElement(numbers, len(numbers)/2)
+//	// median: 25
+func NthElement[T cmp.Ordered](arr []T, n int) (T, error) {
+	if n < 0 || n >= len(arr) {
+		var zero T
+		return zero, ErrorIndexOutOfRange
+	}
+
+	// Create a copy to avoid modifying the original slice
+	work := make([]T, len(arr))
+	copy(work, arr)
+
+	quickSelect(work, n)
+	return work[n], nil
+}
+
+// quickSelect rearranges arr so that arr[target] holds the element that belongs there
+// in sorted order, with no larger element before it and no smaller element after it.
+// It narrows in on target with partition3Way instead of recursing into both halves.
+func quickSelect[T cmp.Ordered](arr []T, target int) {
+	low, high := 0, len(arr)-1
+	for low < high {
+		medianOfThree(arr, low, high)
+		// Three-way partitioning keeps duplicate-heavy input linear: the run equal to the
+		// pivot is settled in one pass instead of shrinking the range by one element.
+		lt, gt := partition3Way(arr, low, high)
+		switch {
+		case target < lt:
+			high = lt - 1
+		case target > gt:
+			low = gt + 1
+		default:
+			return // arr[lt..gt] all equal the pivot, and target is among them
+		}
+	}
+}
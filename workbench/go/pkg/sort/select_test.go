@@ -0,0 +1,121 @@
+package sort
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopK(t *testing.T) {
+	data := []int{50, 90, 10, 70, 30, 80, 70}
+
+	testCases := []struct {
+		name     string
+		k        int
+		expected []int
+	}{
+		{"zero", 0, []int{}},
+		{"negative", -3, []int{}},
+		{"one", 1, []int{90}},
+		{"with duplicate", 4, []int{90, 80, 70, 70}},
+		{"all", len(data), []int{90, 80, 70, 70, 50, 30, 10}},
+		{"more than length", 100, []int{90, 80, 70, 70, 50, 30, 10}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := make([]int, len(data))
+			copy(original, data)
+
+			assert.Equal(t, tc.expected, TopK(data, tc.k))
+			assert.Equal(t, original, data, "TopK should not modify original slice")
+		})
+	}
+
+	t.Run("empty input", func(t *testing.T) {
+		assert.Empty(t, TopK([]int{}, 3))
+	})
+
+	t.Run("against standard library", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			size := rand.Intn(100) + 1
+			data := make([]int, size)
+			for j := range data {
+				data[j] = rand.Intn(1000)
+			}
+			k := rand.Intn(size) + 1
+
+			expected := make([]int, len(data))
+			copy(expected, data)
+			sort.Sort(sort.Reverse(sort.IntSlice(expected)))
+
+			assert.Equal(t, expected[:k], TopK(data, k), "dataset %d, k=%d", i, k)
+		}
+	})
+}
+
+func TestNthElement(t *testing.T) {
+	data := []int{64, 34, 25, 12, 22, 11, 90}
+	sorted := []int{11, 12, 22, 25, 34, 64, 90}
+
+	for n, want := range sorted {
+		got, err := NthElement(data, n)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "element %d", n)
+	}
+	assert.Equal(t, []int{64, 34, 25, 12, 22, 11, 90}, data, "NthElement should not modify original slice")
+
+	for _, n := range []int{-1, len(data)} {
+		_, err := NthElement(data, n)
+		assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+	}
+	_, err := NthElement([]string{}, 0)
+	assert.ErrorIs(t, err, ErrorIndexOutOfRange)
+}
+
+func TestSelect_Duplicates(t *testing.T) {
+	t.Run("all equal", func(t *testing.T) {
+		// With a two-way partition every pass would shrink the range by one element,
+		// making this quadratic; three-way partitioning settles it in one pass.
+		const n = 100000
+		data := make([]int, n)
+		for i := range data {
+			data[i] = 7
+		}
+		got, err := NthElement(data, n/2)
+		require.NoError(t, err)
+		assert.Equal(t, 7, got)
+		assert.Len(t, TopK(data, 10), 10)
+	})
+
+	t.Run("few distinct keys", func(t *testing.T) {
+		data := make([]int, 10000)
+		for i := range data {
+			data[i] = rand.Intn(3)
+		}
+		sorted := append([]int(nil), data...)
+		sort.Ints(sorted)
+		for _, n := range []int{0, 1, len(data) / 3, len(data) / 2, len(data) - 1} {
+			got, err := NthElement(data, n)
+			require.NoError(t, err)
+			assert.Equal(t, sorted[n], got, "element %d", n)
+		}
+		top := TopK(data, 100)
+		sort.Ints(top)
+		assert.Equal(t, sorted[len(sorted)-100:], top)
+	})
+}
+
+func BenchmarkTopK_10of100000(b *testing.B) {
+	data := make([]int, 100000)
+	for i := range data {
+		data[i] = rand.Intn(1000000)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		TopK(data, 10)
+	}
+}
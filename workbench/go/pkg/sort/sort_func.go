@@ -0,0 +1,102 @@
+package sort
+
+// SortFunc sorts a slice with quicksort, ordering elements by the less function.
+//
+// It mirrors slices.SortFunc but keeps this package's convention of returning a new
+// slice instead of sorting in place, and it takes a boolean less function like the
+// rest of this package rather than a three-way comparison.
+// less must report whether a should sort before b and describe a strict weak ordering.
+// The sort is not stable; use MergeSortFunc when equal elements must keep their order.
+//
+// Time Complexity: O(n log n) average case, including sorted, reverse sorted and
+// all-equal input; O(n²) worst case
+// Space Complexity: O(n) for the result, plus O(log n) for the recursion stack
+// Stability: Not stable
+//
+// Example:
+//
+//	type Person struct {
+//	    Name string
+//	    Age  int
+//	}
+//	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}}
+//	byAge := sort.SortFunc(people, func(a, b Person) bool { return a.Age < b.Age })
+//	// byAge: [{Bob 25} {Alice 30} {Carol 35}]
+func SortFunc[T any](arr []T, less func(a, b T) bool) []T {
+	// Create a copy to avoid modifying the original slice
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	quickSortFuncInPlace(result, 0, len(result)-1, less)
+	return result
+}
+
+// quickSortFuncInPlace sorts arr[low..high] in place using less to compare elements.
+// Like quickSortInPlace, it pivots on the median of three and hands subarrays shorter
+// than insertionSortThreshold to insertion sort. It also partitions three ways, so runs
+// of equal keys, common when sorting records by one field, are placed in a single pass.
+func quickSortFuncInPlace[T any](arr []T, low, high int, less func(a, b T) bool) {
+	if high-low+1 < insertionSortThreshold {
+		insertionSortFuncInPlace(arr, low, high, less)
+		return
+	}
+
+	medianOfThreeFunc(arr, low, high, less)
+	lt, gt := partition3WayFunc(arr, low, high, less)
+
+	quickSortFuncInPlace(arr, low, lt-1, less)
+	quickSortFuncInPlace(arr, gt+1, high, less)
+}
+
+// medianOfThreeFunc is medianOfThree comparing elements with less: it orders arr[low],
+// arr[mid] and arr[high], then swaps the median into arr[high] as the pivot.
+func medianOfThreeFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	mid := low + (high-low)/2
+	if less(arr[mid], arr[low]) {
+		arr[mid], arr[low] = arr[low], arr[mid]
+	}
+	if less(arr[high], arr[low]) {
+		arr[high], arr[low] = arr[low], arr[high]
+	}
+	if less(arr[high], arr[mid]) {
+		arr[high], arr[mid] = arr[mid], arr[high]
+	}
+	// arr[low] <= arr[mid] <= arr[high]; move the median into the pivot slot
+	arr[mid], arr[high] = arr[high], arr[mid]
+}
+
+// partition3WayFunc is partition3Way comparing elements with less. It rearranges
+// arr[low..high] around the pivot arr[high] into elements before, equivalent to and after
+// the pivot, and returns the bounds lt and gt of the equivalent region.
+func partition3WayFunc[T any](arr []T, low, high int, less func(a, b T) bool) (int, int) {
+	pivot := arr[high]
+	lt, i, gt := low, low, high
+
+	for i <= gt {
+		switch {
+		case less(arr[i], pivot):
+			arr[lt], arr[i] = arr[i], arr[lt]
+			lt++
+			i++
+		case less(pivot, arr[i]):
+			arr[i], arr[gt] = arr[gt], arr[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// insertionSortFuncInPlace is insertionSortInPlace comparing elements with less.
+func insertionSortFuncInPlace[T any](arr []T, low, high int, less func(a, b T) bool) {
+	for i := low + 1; i <= high; i++ {
+		value := arr[i]
+		j := i - 1
+		for j >= low && less(value, arr[j]) {
+			arr[j+1] = arr[j]
+			j--
+		}
+		arr[j+1] = value
+	}
+}
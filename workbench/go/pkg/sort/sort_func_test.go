@@ -0,0 +1,96 @@
+package sort
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func byAge(a, b person) bool { return a.Age < b.Age }
+
+func TestSortFunc(t *testing.T) {
+	t.Run("empty slice", func(t *testing.T) {
+		assert.Empty(t, SortFunc([]person{}, byAge))
+		assert.Empty(t, MergeSortFunc([]person{}, byAge))
+	})
+
+	t.Run("sort structs by field", func(t *testing.T) {
+		people := []person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}, {"Dave", 20}}
+		original := make([]person, len(people))
+		copy(original, people)
+
+		expected := []person{{"Dave", 20}, {"Bob", 25}, {"Alice", 30}, {"Carol", 35}}
+		assert.Equal(t, expected, SortFunc(people, byAge))
+		assert.Equal(t, expected, MergeSortFunc(people, byAge))
+		assert.Equal(t, original, people, "input should not be modified")
+	})
+
+	t.Run("descending comparator", func(t *testing.T) {
+		greater := func(a, b int) bool { return a > b }
+		data := []int{3, 1, 4, 1, 5, 9, 2, 6}
+		expected := []int{9, 6, 5, 4, 3, 2, 1, 1}
+		assert.Equal(t, expected, SortFunc(data, greater))
+		assert.Equal(t, expected, MergeSortFunc(data, greater))
+	})
+}
+
+func TestSortFunc_PathologicalInputs(t *testing.T) {
+	const n = 10000
+	sorted := make([]person, n)
+	reversed := make([]person, n)
+	equal := make([]person, n)
+	for i := range sorted {
+		sorted[i] = person{Age: i}
+		reversed[i] = person{Age: n - i}
+		equal[i] = person{Age: 42}
+	}
+
+	// n log2 n is about 133,000; a quadratic sort would need about 50,000,000 comparisons.
+	const maxComparisons = 1_000_000
+	for name, data := range map[string][]person{"sorted": sorted, "reverse sorted": reversed, "all equal": equal} {
+		t.Run(name, func(t *testing.T) {
+			comparisons := 0
+			result := SortFunc(data, func(a, b person) bool {
+				comparisons++
+				return a.Age < b.Age
+			})
+			assert.True(t, sort.SliceIsSorted(result, func(i, j int) bool { return result[i].Age < result[j].Age }))
+			assert.Less(t, comparisons, maxComparisons)
+		})
+	}
+}
+
+func TestMergeSortFunc_Stable(t *testing.T) {
+	people := []person{
+		{"Alice", 30}, {"Bob", 25}, {"Carol", 30}, {"Dave", 25}, {"Eve", 30}, {"Frank", 20},
+	}
+	expected := []person{
+		{"Frank", 20}, {"Bob", 25}, {"Dave", 25}, {"Alice", 30}, {"Carol", 30}, {"Eve", 30},
+	}
+	assert.Equal(t, expected, MergeSortFunc(people, byAge))
+}
+
+func TestSortFunc_CorrectnessAgainstStandardLibrary(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	for i := 0; i < 100; i++ {
+		size := rand.Intn(100) + 1 // 1 to 100 elements
+		data := make([]int, size)
+		for j := 0; j < size; j++ {
+			data[j] = rand.Intn(1000)
+		}
+
+		expected := make([]int, len(data))
+		copy(expected, data)
+		sort.Ints(expected)
+
+		assert.Equal(t, expected, SortFunc(data, less), "SortFunc should match standard library for dataset %d", i)
+		assert.Equal(t, expected, MergeSortFunc(data, less), "MergeSortFunc should match standard library for dataset %d", i)
+	}
+}
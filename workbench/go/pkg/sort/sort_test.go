@@ -10,7 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// Test that both sorting algorithms produce the same results
+// Test that all sorting algorithms produce the same results
 func TestSortingAlgorithmsConsistency(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -34,9 +34,18 @@ func TestSortingAlgorithmsConsistency(t *testing.T) {
 			heapResult, err := HeapSort(tc.data)
 			require.NoError(t, err)
 			quickResult := QuickSort(tc.data)
+			mergeResult := MergeSort(tc.data)
+			countingResult := CountingSort(tc.data)
+			radixResult := RadixSort(tc.data)
 
 			assert.Equal(t, heapResult, quickResult,
 				"HeapSort and QuickSort should produce the same result for %s", tc.name)
+			assert.Equal(t, heapResult, mergeResult,
+				"HeapSort and MergeSort should produce the same result for %s", tc.name)
+			assert.Equal(t, heapResult, countingResult,
+				"HeapSort and CountingSort should produce the same result for %s", tc.name)
+			assert.Equal(t, heapResult, radixResult,
+				"HeapSort and RadixSort should produce the same result for %s", tc.name)
 
 			// Verify they match Go's standard library
 			if len(tc.data) > 0 {
@@ -48,6 +57,12 @@ func TestSortingAlgorithmsConsistency(t *testing.T) {
 					"HeapSort should match standard library for %s", tc.name)
 				assert.Equal(t, expected, quickResult,
 					"QuickSort should match standard library for %s", tc.name)
+				assert.Equal(t, expected, mergeResult,
+					"MergeSort should match standard library for %s", tc.name)
+				assert.Equal(t, expected, countingResult,
+					"CountingSort should match standard library for %s", tc.name)
+				assert.Equal(t, expected, radixResult,
+					"RadixSort should match standard library for %s", tc.name)
 			}
 		})
 	}
@@ -156,6 +171,7 @@ func TestQuickSortWorstCase(t *testing.T) {
 	// Should still work correctly despite worst-case input
 	assert.True(t, sort.IntsAreSorted(result), "QuickSort should handle worst-case input")
 	assert.Equal(t, data, result, "Result should be the same as input (already sorted)")
+	assert.Equal(t, data, IntroSort(data), "IntroSort bounds the recursion depth on the same input")
 }
 
 // Test heap sort with worst-case scenario
@@ -192,6 +208,13 @@ func TestSortingWithLargeNumbers(t *testing.T) {
 
 	assert.Equal(t, expected, heapResult, "HeapSort should handle large numbers")
 	assert.Equal(t, expected, quickResult, "QuickSort should handle large numbers")
+	assert.Equal(t, expected, RadixSort(largeNumbers), "RadixSort should handle large numbers")
+
+	// CountingSort allocates one counter per value in the range, so it only
+	// gets large values that are close together
+	bounded := []int{1000000002, 1000000001, 1000000000, 999999999, 1000000000}
+	assert.Equal(t, []int{999999999, 1000000000, 1000000000, 1000000001, 1000000002}, CountingSort(bounded),
+		"CountingSort should handle large numbers in a bounded range")
 }
 
 // Test with custom ordered type
@@ -237,3 +260,42 @@ func TestStressTestMultipleDatasets(t *testing.T) {
 			"QuickSort result should be sorted for dataset %d", i)
 	}
 }
+
+// Test that the in-place variants sort the caller's slice directly
+func TestInPlaceSorts(t *testing.T) {
+	inPlaceSorts := map[string]func([]int){
+		"HeapSortInPlace":  HeapSortInPlace[int],
+		"QuickSortInPlace": QuickSortInPlace[int],
+	}
+
+	for name, sortInPlace := range inPlaceSorts {
+		t.Run(name, func(t *testing.T) {
+			empty := []int{}
+			sortInPlace(empty)
+			assert.Empty(t, empty)
+
+			single := []int{42}
+			sortInPlace(single)
+			assert.Equal(t, []int{42}, single)
+
+			data := []int{3, 1, 4, 1, 5, 9, 2, 6, 5}
+			backing := &data[0]
+			sortInPlace(data)
+			assert.Equal(t, []int{1, 1, 2, 3, 4, 5, 5, 6, 9}, data)
+			assert.Same(t, backing, &data[0], "%s should reuse the caller's backing array", name)
+
+			for i := 0; i < 100; i++ {
+				data := make([]int, rand.Intn(200)+1)
+				for j := range data {
+					data[j] = rand.Intn(1000) - 500
+				}
+				expected := make([]int, len(data))
+				copy(expected, data)
+				sort.Ints(expected)
+
+				sortInPlace(data)
+				assert.Equal(t, expected, data, "%s dataset %d", name, i)
+			}
+		})
+	}
+}
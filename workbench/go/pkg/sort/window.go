@@ -0,0 +1,52 @@
+package sort
+
+import (
+	"cmp"
+
+	"github.com/haru-256/ctci-6th-edition/pkg/queue"
+)
+
+// SlidingWindowMax returns the maximum of every contiguous window of k elements,
+// so the result has len(arr)-k+1 entries and result[i] = max(arr[i : i+k]).
+//
+// It keeps a monotonic queue.Deque of indices whose values decrease from front to back:
+// the front is always the maximum of the current window, indices that slide out are
+// popped from the front, and indices whose values can never be a maximum again are
+// popped from the back. Every index is pushed and popped at most once.
+// If k <= 0 or k > len(arr) there is no complete window and an empty slice is returned.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(k) for the deque, plus O(n) for the result
+//
+// Example:
+//
+//	data := []int{1, 3, -1, -3, 5, 3, 6, 7}
+//	maxima := sort.SlidingWindowMax(data, 3)
+//	// maxima: [3, 3, 5, 5, 6, 7]
+func SlidingWindowMax[T cmp.Ordered](arr []T, k int) []T {
+	if k <= 0 || k > len(arr) {
+		return []T{}
+	}
+
+	result := make([]T, 0, len(arr)-k+1)
+	// The window never holds more than k indices, so the deque cannot overflow
+	// and its errors can be ignored.
+	window := queue.NewDeque[int](k)
+	for i, v := range arr {
+		// Drop the front index once it falls out of the window arr[i-k+1 : i+1]
+		if front, err := window.PeekFront(); err == nil && front <= i-k {
+			_, _ = window.PopFront()
+		}
+		// Drop smaller values from the back; they can never be a window maximum again
+		for back, err := window.PeekBack(); err == nil && arr[back] <= v; back, err = window.PeekBack() {
+			_, _ = window.PopBack()
+		}
+		_ = window.PushBack(i)
+
+		if i >= k-1 {
+			front, _ := window.PeekFront()
+			result = append(result, arr[front])
+		}
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package sort
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlidingWindowMax(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []int
+		k        int
+		expected []int
+	}{
+		{"classic example", []int{1, 3, -1, -3, 5, 3, 6, 7}, 3, []int{3, 3, 5, 5, 6, 7}},
+		{"window of one", []int{4, 2, 12, 3}, 1, []int{4, 2, 12, 3}},
+		{"whole slice", []int{4, 2, 12, 3}, 4, []int{12}},
+		{"decreasing", []int{9, 7, 5, 3, 1}, 2, []int{9, 7, 5, 3}},
+		{"increasing", []int{1, 3, 5, 7, 9}, 2, []int{3, 5, 7, 9}},
+		{"duplicates", []int{2, 2, 2, 1, 2}, 3, []int{2, 2, 2}},
+		{"zero window", []int{1, 2, 3}, 0, []int{}},
+		{"negative window", []int{1, 2, 3}, -1, []int{}},
+		{"window larger than data", []int{1, 2, 3}, 4, []int{}},
+		{"empty data", []int{}, 1, []int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SlidingWindowMax(tc.data, tc.k))
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		words := []string{"kiwi", "apple", "mango", "fig", "banana"}
+		assert.Equal(t, []string{"kiwi", "mango", "mango", "fig"}, SlidingWindowMax(words, 2))
+	})
+
+	t.Run("against brute force", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			data := make([]int, rand.Intn(100)+1)
+			for j := range data {
+				data[j] = rand.Intn(50)
+			}
+			k := rand.Intn(len(data)) + 1
+
+			expected := make([]int, 0, len(data)-k+1)
+			for start := 0; start+k <= len(data); start++ {
+				expected = append(expected, slices.Max(data[start:start+k]))
+			}
+			assert.Equal(t, expected, SlidingWindowMax(data, k), "dataset %d, k=%d", i, k)
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package stack
+
+import (
+	"sync"
+)
+
+// ConcurrentStack is a fixed-capacity stack that is safe for use by multiple goroutines.
+// It wraps a Stack behind a single sync.Mutex, so every operation, including a check
+// followed by an action inside Do, runs as one critical section.
+// The zero value is not ready to use; use NewConcurrentStack to create a new stack.
+//
+// Push and Pop return the same ErrorStackOverflow and ErrorStackUnderflow errors as Stack.
+//
+// Time complexity:
+//   - Push: O(1)
+//   - Pop: O(1)
+//   - Peek: O(1)
+//   - IsEmpty/IsFull/Size/Count: O(1)
+//
+// Space complexity: O(n) where n is the capacity.
+type ConcurrentStack[T any] struct {
+	stack *Stack[T]
+	mu    sync.Mutex
+}
+
+// NewConcurrentStack creates and returns a new ConcurrentStack with the specified capacity.
+// The size parameter must be greater than 0, otherwise the function will panic.
+//
+// Example:
+//
+//	s := NewConcurrentStack[int](100)
+//	go func() { _ = s.Push(1) }()
+func NewConcurrentStack[T any](size int) *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{
+		stack: NewStack[T](size),
+	}
+}
+
+// Push adds an item to the top of the stack.
+// Returns ErrorStackOverflow if the stack is full.
+func (s *ConcurrentStack[T]) Push(item T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Push(item)
+}
+
+// Pop removes and returns the top item of the stack.
+// Returns ErrorStackUnderflow if the stack is empty.
+func (s *ConcurrentStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Pop()
+}
+
+// Peek returns the top item of the stack without removing it.
+// Returns ErrorStackUnderflow if the stack is empty.
+func (s *ConcurrentStack[T]) Peek() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Peek()
+}
+
+// IsEmpty returns true if the stack has no items.
+func (s *ConcurrentStack[T]) IsEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.IsEmpty()
+}
+
+// IsFull returns true if the stack has reached its capacity.
+func (s *ConcurrentStack[T]) IsFull() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.IsFull()
+}
+
+// Size returns the maximum capacity of the stack.
+func (s *ConcurrentStack[T]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Size()
+}
+
+// Count returns the current number of items in the stack.
+func (s *ConcurrentStack[T]) Count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Count()
+}
+
+// Do calls fn with the underlying stack while holding the lock, so a sequence such as
+// "pop only if the top matches" happens atomically. fn must not retain the stack or
+// call back into s.
+//
+// Example:
+//
+//	s.Do(func(st *Stack[int]) {
+//	    if top, err := st.Peek(); err == nil && top == 0 {
+//	        _, _ = st.Pop()
+//	    }
+//	})
+func (s *ConcurrentStack[T]) Do(fn func(stack *Stack[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn(s.stack)
+}
@@ -0,0 +1,109 @@
+package stack
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestConcurrentStack_Operations(t *testing.T) {
+	s := NewConcurrentStack[int](2)
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 2, s.Size())
+
+	_, err := s.Pop()
+	assert.ErrorIs(t, err, ErrorStackUnderflow)
+	_, err = s.Peek()
+	assert.ErrorIs(t, err, ErrorStackUnderflow)
+
+	require.NoError(t, s.Push(1))
+	require.NoError(t, s.Push(2))
+	assert.True(t, s.IsFull())
+	assert.ErrorIs(t, s.Push(3), ErrorStackOverflow)
+	assert.Equal(t, 2, s.Count())
+
+	top, err := s.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, 2, top)
+
+	val, err := s.Pop()
+	require.NoError(t, err)
+	assert.Equal(t, 2, val)
+	assert.Equal(t, 1, s.Count())
+
+	assert.Panics(t, func() { NewConcurrentStack[int](0) })
+}
+
+func TestConcurrentStack_ConcurrentPushPop(t *testing.T) {
+	t.Parallel()
+
+	const numGoroutines = 8
+	const itemsPerGoroutine = 200
+
+	s := NewConcurrentStack[int](numGoroutines * itemsPerGoroutine)
+	var g errgroup.Group
+
+	// Concurrent pushes fill the stack exactly
+	for i := 0; i < numGoroutines; i++ {
+		i := i
+		g.Go(func() error {
+			for j := 0; j < itemsPerGoroutine; j++ {
+				if err := s.Push(i*itemsPerGoroutine + j); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait(), "concurrent pushes should not fail")
+	require.True(t, s.IsFull())
+
+	// Concurrent pops drain it; every item comes out exactly once
+	var popped [numGoroutines * itemsPerGoroutine]atomic.Int32
+	for i := 0; i < numGoroutines; i++ {
+		g.Go(func() error {
+			for j := 0; j < itemsPerGoroutine; j++ {
+				val, err := s.Pop()
+				if err != nil {
+					return err
+				}
+				popped[val].Add(1)
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait(), "concurrent pops should not fail")
+	assert.True(t, s.IsEmpty())
+	for val := range popped {
+		require.Equal(t, int32(1), popped[val].Load(), "item %d popped wrong number of times", val)
+	}
+}
+
+func TestConcurrentStack_Do(t *testing.T) {
+	t.Parallel()
+
+	s := NewConcurrentStack[int](1)
+	var g errgroup.Group
+	var pushed atomic.Int32
+
+	// Check-then-push inside Do never overflows
+	for i := 0; i < 50; i++ {
+		i := i
+		g.Go(func() error {
+			var err error
+			s.Do(func(st *Stack[int]) {
+				if !st.IsFull() {
+					err = st.Push(i)
+					pushed.Add(1)
+				}
+			})
+			return err
+		})
+	}
+	require.NoError(t, g.Wait())
+	assert.Equal(t, int32(1), pushed.Load())
+	assert.Equal(t, 1, s.Count())
+}
@@ -7,6 +7,7 @@
 // Key Features:
 //   - Generic implementation supporting any type T
 //   - Fixed-capacity array-based storage for O(1) operations
+//   - Optional dynamic stack that grows and shrinks with its contents
 //   - Thread-unsafe operations for maximum performance
 //   - Comprehensive error handling with custom error types
 //   - Zero-value safety with proper initialization checks
@@ -21,9 +22,53 @@
 //   - Space Complexity: O(n) where n is the stack capacity
 //   - Memory Usage: Fixed at creation time, no dynamic allocation during operations
 //
+// Dynamic Stack:
+// NewDynamicStack creates a stack without a fixed capacity. Its backing slice doubles
+// when full and halves when at most a quarter full, so Push never returns
+// ErrorStackOverflow and runs in amortized O(1) time.
+//
+//	d := stack.NewDynamicStack[int]()
+//	for i := 0; i < 1000; i++ {
+//	    _ = d.Push(i)
+//	}
+//
+// Automatic shrinking leaves up to four times the needed capacity allocated. ShrinkToFit
+// reallocates the backing slice down to the current number of items, which helps
+// long-lived stacks release memory after a spike:
+//
+//	d.ShrinkToFit()
+//
+// Batch Operations:
+// PushAll and PopN move many items under a single lock. PushAll pushes until the stack is
+// full and reports how many items it accepted, returning ErrorStackOverflow for the rest;
+// PopN returns up to n items in LIFO order, with the former top first:
+//
+//	n, err := s.PushAll([]int{1, 2, 3})
+//	group, err := s.PopN(2) // [3 2]
+//
+// Min Stack:
+// MinStack is a fixed-capacity stack whose Min method returns the smallest item in O(1)
+// by keeping an auxiliary stack of running minimums alongside the items.
+//
+//	m := stack.NewMinStack[int](10)
+//	_ = m.Push(5)
+//	_ = m.Push(3)
+//	min, _ := m.Min() // min = 3
+//	_, _ = m.Pop()
+//	min, _ = m.Min()  // min = 5
+//
 // Thread Safety:
 // This implementation is NOT thread-safe. If you need concurrent access,
-// you must provide your own synchronization mechanisms.
+// use ConcurrentStack, which wraps a Stack behind a mutex and adds Do for
+// running a check-then-act sequence atomically:
+//
+//	cs := stack.NewConcurrentStack[int](100)
+//	go func() { _ = cs.Push(1) }()
+//	cs.Do(func(s *stack.Stack[int]) {
+//	    if !s.IsEmpty() {
+//	        _, _ = s.Pop()
+//	    }
+//	})
 //
 // Example usage:
 //
@@ -47,12 +92,36 @@
 //	// Peek at the top without removing
 //	val, _ = s.Peek() // val = 1, item remains on stack
 //
+//	// Look at the oldest item, or walk from newest to oldest
+//	val, _ = s.PeekBottom()
+//	s.ForEachTopToBottom(func(item int) bool { fmt.Println(item); return true })
+//
+//	// Range over a snapshot from bottom to top, e.g. with the collections package
+//	total := collections.Reduce(s.All(), 0, func(acc, item int) int { return acc + item })
+//
 //	// Check stack state
 //	fmt.Println("Empty:", s.IsEmpty()) // false
 //	fmt.Println("Full:", s.IsFull())   // false
 //	fmt.Println("Count:", s.Count())   // 1 (current items)
 //	fmt.Println("Size:", s.Size())     // 10 (total capacity)
 //
+//	// Capacity and Len are aliases of Size and Count with less ambiguous names
+//	fmt.Println("Capacity:", s.Capacity())       // 10
+//	fmt.Println("Len:", s.Len())                 // 1
+//	fmt.Println("Utilization:", s.Utilization()) // 0.1
+//
+//	// For comparable items, Equal compares two stacks' items in order without popping them
+//	fmt.Println("Equal:", stack.Equal(s, s.Clone())) // true
+//
+// Checkpointing:
+// Stack implements gob.GobEncoder and gob.GobDecoder, so it can be saved with encoding/gob
+// and restored into a new value with the same items, capacity and LIFO order:
+//
+//	var buf bytes.Buffer
+//	_ = gob.NewEncoder(&buf).Encode(s)
+//	var restored stack.Stack[int]
+//	_ = gob.NewDecoder(&buf).Decode(&restored)
+//
 // Error Handling:
 // The stack operations return specific errors for different failure conditions:
 //   - ErrorStackOverflow: Returned when trying to push to a full stack
@@ -0,0 +1,73 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+// encodedStack is the gob wire format of a Stack.
+// Items are stored from bottom to top, the same order as ToSlice.
+type encodedStack[T any] struct {
+	Items    []T
+	Capacity int
+	Dynamic  bool
+}
+
+// GobEncode implements gob.GobEncoder so that a Stack can be checkpointed with encoding/gob,
+// either on its own or as a field of a larger value.
+// It records the items, the capacity and whether the stack is dynamic.
+// T must itself be encodable by gob; interface types need to be registered with gob.Register.
+// This method is thread-safe and uses a read lock for concurrent access.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	err := gob.NewEncoder(&buf).Encode(s)
+func (s *Stack[T]) GobEncode() ([]byte, error) {
+	s.mu.RLock()
+	encoded := encodedStack[T]{
+		Items:    s.items[:s.count],
+		Capacity: s.size,
+		Dynamic:  s.dynamic,
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(encoded)
+	s.mu.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder and replaces the contents of the stack with the
+// encoded items, so that popping the decoded stack yields them in the original LIFO order.
+// The capacity and the dynamic flag are restored as well. The receiver may be a zero Stack.
+// It returns an error if the data is malformed or holds more items than its capacity.
+// This method is thread-safe and uses a write lock for concurrent access.
+//
+// Example:
+//
+//	var restored stack.Stack[int]
+//	err := gob.NewDecoder(&buf).Decode(&restored)
+func (s *Stack[T]) GobDecode(data []byte) error {
+	var encoded encodedStack[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&encoded); err != nil {
+		return err
+	}
+	if encoded.Capacity <= 0 || len(encoded.Items) > encoded.Capacity {
+		return fmt.Errorf("stack: cannot decode %d items with capacity %d", len(encoded.Items), encoded.Capacity)
+	}
+
+	items := make([]T, encoded.Capacity)
+	copy(items, encoded.Items)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items = items
+	s.size = encoded.Capacity
+	s.count = len(encoded.Items)
+	s.dynamic = encoded.Dynamic
+	return nil
+}
@@ -0,0 +1,82 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStack_Gob(t *testing.T) {
+	t.Run("round trip preserves LIFO order", func(t *testing.T) {
+		s := NewStack[string](5)
+		for _, v := range []string{"a", "b", "c"} {
+			require.NoError(t, s.Push(v))
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(s))
+
+		var restored Stack[string]
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&restored))
+		assert.Equal(t, 5, restored.Size())
+		assert.Equal(t, 3, restored.Count())
+
+		for !s.IsEmpty() {
+			want, err := s.Pop()
+			require.NoError(t, err)
+			got, err := restored.Pop()
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+		assert.True(t, restored.IsEmpty())
+	})
+
+	t.Run("fixed capacity is restored", func(t *testing.T) {
+		s := NewStack[int](2)
+		require.NoError(t, s.Push(1))
+		require.NoError(t, s.Push(2))
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(s))
+		restored := NewStack[int](10)
+		require.NoError(t, gob.NewDecoder(&buf).Decode(restored))
+		assert.True(t, restored.IsFull())
+		assert.ErrorIs(t, restored.Push(3), ErrorStackOverflow)
+	})
+
+	t.Run("dynamic stack keeps growing", func(t *testing.T) {
+		s := NewDynamicStack[int]()
+		for i := 0; i < 10; i++ {
+			require.NoError(t, s.Push(i))
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(s))
+		var restored Stack[int]
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&restored))
+		for i := 10; i < 100; i++ {
+			require.NoError(t, restored.Push(i))
+		}
+		assert.Equal(t, 100, restored.Count())
+	})
+
+	t.Run("empty stack", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(NewStack[int](3)))
+		var restored Stack[int]
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&restored))
+		assert.True(t, restored.IsEmpty())
+		assert.Equal(t, 3, restored.Size())
+	})
+
+	t.Run("inconsistent data", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(encodedStack[int]{Items: []int{1, 2, 3}, Capacity: 2}))
+		var restored Stack[int]
+		assert.Error(t, restored.GobDecode(buf.Bytes()))
+		assert.Error(t, restored.GobDecode([]byte("not gob")))
+	})
+}
@@ -0,0 +1,127 @@
+package stack
+
+import (
+	"cmp"
+	"sync"
+)
+
+// MinStack is a fixed-capacity LIFO stack that also reports its minimum item in O(1).
+// Alongside the items it keeps an auxiliary stack of running minimums: a value is pushed
+// onto it whenever it is less than or equal to the current minimum, and popped from it
+// when that value leaves the main stack.
+// The zero value is not ready to use; use NewMinStack to create a new stack.
+//
+// Time complexity:
+//   - Push/Pop/Peek/Min: O(1)
+//   - IsEmpty/IsFull/Size/Count: O(1)
+//
+// Space complexity: O(n) where n is the capacity.
+type MinStack[T cmp.Ordered] struct {
+	items *Stack[T] // stack holding every pushed item
+	mins  *Stack[T] // stack of running minimums, with the current minimum on top
+	mu    sync.RWMutex
+}
+
+// NewMinStack creates and returns a new MinStack with the specified capacity.
+// The size parameter must be greater than 0, otherwise the function will panic.
+//
+// Example:
+//
+//	stack := NewMinStack[int](10)
+//	_ = stack.Push(5)
+//	_ = stack.Push(3)
+//	min, _ := stack.Min() // min = 3
+func NewMinStack[T cmp.Ordered](size int) *MinStack[T] {
+	if size <= 0 {
+		panic("stack size must be greater than 0")
+	}
+	return &MinStack[T]{
+		items: NewStack[T](size),
+		mins:  NewStack[T](size),
+	}
+}
+
+// IsEmpty checks if the stack is empty.
+// Returns true if there are no elements in the stack.
+func (s *MinStack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.items.IsEmpty()
+}
+
+// IsFull checks if the stack is full.
+// Returns true if the stack has reached its maximum capacity.
+func (s *MinStack[T]) IsFull() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.items.IsFull()
+}
+
+// Push adds an item to the top of the stack and updates the running minimum.
+// Returns ErrorStackOverflow if the stack is full.
+func (s *MinStack[T]) Push(item T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.items.Push(item); err != nil {
+		return err
+	}
+	if current, err := s.mins.Peek(); err != nil || item <= current {
+		// mins never holds more items than the main stack, so it cannot overflow here.
+		_ = s.mins.Push(item)
+	}
+	return nil
+}
+
+// Pop removes and returns the top item from the stack, restoring the previous minimum
+// if the popped item was the current minimum.
+// Returns ErrorStackUnderflow if the stack is empty.
+func (s *MinStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, err := s.items.Pop()
+	if err != nil {
+		return item, err
+	}
+	if current, err := s.mins.Peek(); err == nil && item == current {
+		_, _ = s.mins.Pop()
+	}
+	return item, nil
+}
+
+// Peek returns the top item from the stack without removing it.
+// Returns ErrorStackUnderflow if the stack is empty.
+func (s *MinStack[T]) Peek() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.items.Peek()
+}
+
+// Min returns the smallest item currently in the stack without removing it.
+// Returns ErrorStackUnderflow if the stack is empty.
+func (s *MinStack[T]) Min() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.mins.Peek()
+}
+
+// Size returns the maximum capacity of the stack.
+func (s *MinStack[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.items.Size()
+}
+
+// Count returns the current number of items in the stack.
+func (s *MinStack[T]) Count() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.items.Count()
+}
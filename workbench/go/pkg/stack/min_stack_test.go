@@ -0,0 +1,82 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMinStack(t *testing.T) {
+	s := NewMinStack[int](3)
+	require.NotNil(t, s)
+	assert.True(t, s.IsEmpty())
+	assert.False(t, s.IsFull())
+	assert.Equal(t, 3, s.Size())
+	assert.Equal(t, 0, s.Count())
+
+	_, err := s.Min()
+	assert.Equal(t, ErrorStackUnderflow, err)
+	_, err = s.Pop()
+	assert.Equal(t, ErrorStackUnderflow, err)
+	_, err = s.Peek()
+	assert.Equal(t, ErrorStackUnderflow, err)
+
+	assert.Panics(t, func() { NewMinStack[int](0) })
+}
+
+func TestMinStack_Min(t *testing.T) {
+	s := NewMinStack[int](10)
+
+	pushes := []struct {
+		value int
+		min   int
+	}{
+		{5, 5},
+		{7, 5},
+		{3, 3},
+		{3, 3}, // duplicate minimum must survive a single pop
+		{8, 3},
+		{1, 1},
+	}
+	for _, p := range pushes {
+		require.NoError(t, s.Push(p.value))
+		got, err := s.Min()
+		require.NoError(t, err)
+		assert.Equal(t, p.min, got, "after pushing %d", p.value)
+	}
+
+	// Popping walks the minimums back in reverse
+	for i := len(pushes) - 1; i > 0; i-- {
+		popped, err := s.Pop()
+		require.NoError(t, err)
+		assert.Equal(t, pushes[i].value, popped)
+
+		got, err := s.Min()
+		require.NoError(t, err)
+		assert.Equal(t, pushes[i-1].min, got, "after popping %d", popped)
+	}
+
+	_, err := s.Pop()
+	require.NoError(t, err)
+	assert.True(t, s.IsEmpty())
+	_, err = s.Min()
+	assert.Equal(t, ErrorStackUnderflow, err)
+}
+
+func TestMinStack_Overflow(t *testing.T) {
+	s := NewMinStack[string](2)
+	require.NoError(t, s.Push("b"))
+	require.NoError(t, s.Push("a"))
+	assert.True(t, s.IsFull())
+
+	assert.Equal(t, ErrorStackOverflow, s.Push("0"))
+	got, err := s.Min()
+	require.NoError(t, err)
+	assert.Equal(t, "a", got, "rejected push must not change the minimum")
+
+	top, err := s.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "a", top)
+	assert.Equal(t, 2, s.Count())
+}
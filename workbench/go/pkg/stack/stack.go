@@ -2,6 +2,8 @@ package stack
 
 import (
 	"errors"
+	"iter"
+	"slices"
 	"sync"
 )
 
@@ -12,6 +14,10 @@ var (
 	ErrorStackUnderflow = errors.New("stack underflow")
 )
 
+// minDynamicCapacity is the initial capacity of a dynamic stack and the
+// smallest capacity it shrinks back to.
+const minDynamicCapacity = 4
+
 // Stack represents a generic LIFO (Last In, First Out) stack using a fixed-size array.
 // It stores values of type T directly and provides thread-unsafe operations.
 // The zero value is not ready to use; use NewStack to create a new stack.
@@ -31,7 +37,9 @@ type Stack[T any] struct {
 	items []T // slice to store stack items
 	size  int // maximum number of items the stack can hold
 	count int // current number of items in the stack
-	mu    sync.RWMutex
+	// dynamic reports whether the stack grows on Push and shrinks on Pop instead of overflowing
+	dynamic bool
+	mu      sync.RWMutex
 }
 
 // NewStack creates and returns a new Stack with the specified capacity.
@@ -65,6 +73,28 @@ func NewStack[T any](size int) *Stack[T] {
 	}
 }
 
+// NewDynamicStack creates and returns a new, empty Stack without a fixed capacity.
+// The backing slice doubles when Push finds it full and halves when Pop leaves it
+// at most a quarter full, so Push never returns ErrorStackOverflow and IsFull always
+// reports false. Size reports the current capacity of the backing slice.
+//
+// Push runs in amortized O(1) time: a resize copies all items, but it happens only
+// after a number of operations proportional to the stack's size.
+//
+// Example:
+//
+//	stack := NewDynamicStack[int]()
+//	for i := 0; i < 1000; i++ {
+//	    _ = stack.Push(i) // never overflows
+//	}
+func NewDynamicStack[T any]() *Stack[T] {
+	return &Stack[T]{
+		items:   make([]T, minDynamicCapacity),
+		size:    minDynamicCapacity,
+		dynamic: true,
+	}
+}
+
 // IsEmpty checks if the stack is empty.
 // Returns true if there are no elements in the stack.
 func (s *Stack[T]) IsEmpty() bool {
@@ -76,15 +106,17 @@ func (s *Stack[T]) IsEmpty() bool {
 
 // IsFull checks if the stack is full.
 // Returns true if the stack has reached its maximum capacity.
+// A dynamic stack is never full.
 func (s *Stack[T]) IsFull() bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.count == s.size
+	return !s.dynamic && s.count == s.size
 }
 
 // Push adds an item to the top of the stack.
 // Returns ErrorStackOverflow if the stack is full.
+// A dynamic stack doubles its capacity instead of returning an error.
 //
 // Example:
 //
@@ -100,7 +132,10 @@ func (s *Stack[T]) Push(item T) error {
 	// We cannot call s.IsFull() here because it would cause a deadlock:
 	// IsFull() tries to acquire an RLock while we already hold a Lock.
 	if s.count == s.size {
-		return ErrorStackOverflow
+		if !s.dynamic {
+			return ErrorStackOverflow
+		}
+		s.resize(s.size * 2)
 	}
 
 	s.items[s.count] = item
@@ -111,6 +146,7 @@ func (s *Stack[T]) Push(item T) error {
 // Pop removes and returns the top item from the stack.
 // Returns ErrorStackUnderflow if the stack is empty.
 // The stack follows LIFO (Last In, First Out) order.
+// A dynamic stack halves its capacity once it is at most a quarter full.
 //
 // Example:
 //
@@ -135,9 +171,84 @@ func (s *Stack[T]) Pop() (T, error) {
 	item := s.items[s.count-1]
 	s.items[s.count-1] = zero // Clear the reference to prevent memory leaks
 	s.count--
+	if s.dynamic && s.size > minDynamicCapacity && s.count <= s.size/4 {
+		s.resize(max(s.size/2, minDynamicCapacity))
+	}
 	return item, nil
 }
 
+// PushAll pushes items onto the stack in order, under a single lock, so the last item
+// ends up on top. It pushes until the stack is full and returns how many items were accepted;
+// if some did not fit, it also returns ErrorStackOverflow and the remaining items are left out.
+// A dynamic stack grows at most once to hold all items and never overflows.
+//
+// Time complexity: O(k) where k is len(items).
+//
+// Example:
+//
+//	s := NewStack[int](3)
+//	n, err := s.PushAll([]int{1, 2, 3, 4}) // n == 3, err == ErrorStackOverflow, 3 on top
+func (s *Stack[T]) PushAll(items []T) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dynamic && s.count+len(items) > s.size {
+		capacity := s.size
+		for capacity < s.count+len(items) {
+			capacity *= 2
+		}
+		s.resize(capacity)
+	}
+
+	accepted := copy(s.items[s.count:], items)
+	s.count += accepted
+	if accepted < len(items) {
+		return accepted, ErrorStackOverflow
+	}
+	return accepted, nil
+}
+
+// PopN removes up to n items from the top of the stack, under a single lock, and returns
+// them in LIFO order, so the first element is the former top. If the stack holds fewer than
+// n items, all of them are returned. It returns ErrorStackUnderflow if n > 0 and the stack
+// is empty; if n <= 0, it returns an empty slice and no error.
+// A dynamic stack shrinks afterwards as Pop would.
+//
+// Time complexity: O(k) where k is the number of items returned.
+//
+// Example:
+//
+//	_, _ = s.PushAll([]int{1, 2, 3})
+//	items, err := s.PopN(2) // [3 2]
+func (s *Stack[T]) PopN(n int) ([]T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n <= 0 {
+		return []T{}, nil
+	}
+	if s.count == 0 {
+		return nil, ErrorStackUnderflow
+	}
+
+	items := make([]T, min(n, s.count))
+	for i := range items {
+		items[i] = s.items[s.count-1-i]
+	}
+	clear(s.items[s.count-len(items) : s.count]) // Clear the references to prevent memory leaks
+	s.count -= len(items)
+	if s.dynamic {
+		capacity := s.size
+		for capacity > minDynamicCapacity && s.count <= capacity/4 {
+			capacity = max(capacity/2, minDynamicCapacity)
+		}
+		if capacity < s.size {
+			s.resize(capacity)
+		}
+	}
+	return items, nil
+}
+
 // Peek returns the top item from the stack without removing it.
 // Returns ErrorStackUnderflow if the stack is empty.
 // This operation does not modify the stack.
@@ -166,8 +277,47 @@ func (s *Stack[T]) Peek() (T, error) {
 	return s.items[s.count-1], nil
 }
 
-// Size returns the maximum capacity of the stack.
-// This is the size that was specified when the stack was created.
+// PeekBottom returns the bottom item of the stack, the oldest one still present,
+// without removing it.
+// Returns ErrorStackUnderflow if the stack is empty.
+// This operation does not modify the stack.
+func (s *Stack[T]) PeekBottom() (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.count == 0 {
+		var zero T
+		return zero, ErrorStackUnderflow
+	}
+
+	return s.items[0], nil
+}
+
+// ForEachTopToBottom calls fn for each item from the top of the stack (newest) down to
+// the bottom (oldest), stopping early when fn returns false.
+// The stack holds a read lock for the whole iteration, so fn must not modify the stack.
+// This operation does not modify the stack.
+//
+// Example:
+//
+//	s.ForEachTopToBottom(func(item int) bool {
+//	    fmt.Println(item)
+//	    return true // keep going
+//	})
+func (s *Stack[T]) ForEachTopToBottom(fn func(T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for i := s.count - 1; i >= 0; i-- {
+		if !fn(s.items[i]) {
+			return
+		}
+	}
+}
+
+// Size returns the maximum capacity of the stack, not the number of items; see Count.
+// This is the size that was specified when the stack was created,
+// or the current capacity of the backing slice for a dynamic stack.
 func (s *Stack[T]) Size() int {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -183,3 +333,160 @@ func (s *Stack[T]) Count() int {
 
 	return s.count
 }
+
+// Capacity returns the maximum number of items the stack can currently hold.
+// It is the same as Size; the name makes clear that Size reports capacity, not the item count.
+func (s *Stack[T]) Capacity() int {
+	return s.Size()
+}
+
+// Len returns the current number of items in the stack.
+// It is the same as Count, named after the built-in len for callers who expect Size to mean the item count.
+func (s *Stack[T]) Len() int {
+	return s.Count()
+}
+
+// Utilization returns the fraction of the capacity in use, Count()/Capacity(),
+// ranging from 0 (empty) to 1 (full).
+// Both values are read under a single lock, so the result is consistent under concurrent use.
+func (s *Stack[T]) Utilization() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return float64(s.count) / float64(s.size)
+}
+
+// ToSlice returns a copy of the items in the stack ordered from bottom to top,
+// so the last element is the item Pop would return next.
+// The returned slice does not share memory with the stack.
+func (s *Stack[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := make([]T, s.count)
+	copy(items, s.items[:s.count])
+	return items
+}
+
+// All returns an iterator over the items in the stack from bottom to top, the same order
+// as ToSlice, for use with range-over-func and the collections package.
+// The items are copied when iteration starts, so the loop body may modify the stack and
+// sees the stack as it was at that moment.
+//
+// Example:
+//
+//	for item := range s.All() {
+//	    fmt.Println(item)
+//	}
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.ToSlice() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all items from the stack while keeping its capacity.
+// The backing slice is reused; its slots are zeroed to release references.
+func (s *Stack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.items[:s.count])
+	s.count = 0
+}
+
+// Clone returns an independent copy of the stack with the same capacity and items.
+// The copy does not share its backing array with the original.
+func (s *Stack[T]) Clone() *Stack[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := make([]T, s.size)
+	copy(items, s.items[:s.count])
+	return &Stack[T]{
+		items:   items,
+		size:    s.size,
+		count:   s.count,
+		dynamic: s.dynamic,
+	}
+}
+
+// Search returns the 1-based distance of value from the top of the stack,
+// where 1 means value is the top item, or -1 if value is not in the stack.
+// It scans from the top downward, so the nearest occurrence wins.
+// Search is a function rather than a method because it requires T to be comparable,
+// which the Stack type itself does not.
+//
+// Example:
+//
+//	s := NewStack[int](5)
+//	_ = s.Push(1)
+//	_ = s.Push(2)
+//	Search(s, 2) // 1
+//	Search(s, 1) // 2
+//	Search(s, 9) // -1
+func Search[T comparable](s *Stack[T], value T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for i := s.count - 1; i >= 0; i-- {
+		if s.items[i] == value {
+			return s.count - i
+		}
+	}
+	return -1
+}
+
+// Equal reports whether a and b hold the same items in the same order, from bottom to top,
+// without popping anything from either stack. Only the live items are compared, so stacks
+// with different capacities or modes can be equal.
+// Equal is a function rather than a method because it requires T to be comparable,
+// which the Stack type itself does not.
+//
+// The stacks are never locked at the same time: a's items are copied under its read lock
+// and then compared under b's, so Equal cannot deadlock with a concurrent Equal(b, a).
+//
+// Time complexity: O(n) where n is the number of items in a.
+//
+// Example:
+//
+//	assert.True(t, stack.Equal(s, s.Clone()))
+func Equal[T comparable](a, b *Stack[T]) bool {
+	if a == b {
+		return true
+	}
+	items := a.ToSlice()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return slices.Equal(items, b.items[:b.count])
+}
+
+// ShrinkToFit reallocates the backing slice of a dynamic stack so that its capacity
+// equals the current number of items, but no less than the minimum dynamic capacity.
+// Use it to release memory after a spike; the items and their order are unchanged.
+// A fixed-capacity stack is left as is, since its capacity is part of its contract.
+func (s *Stack[T]) ShrinkToFit() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.dynamic {
+		return
+	}
+	if capacity := max(s.count, minDynamicCapacity); capacity < s.size {
+		s.resize(capacity)
+	}
+}
+
+// resize moves the items into a new backing slice that can hold capacity items.
+// This method assumes the caller already holds the write lock.
+func (s *Stack[T]) resize(capacity int) {
+	items := make([]T, capacity)
+	copy(items, s.items[:s.count])
+	s.items = items
+	s.size = capacity
+}
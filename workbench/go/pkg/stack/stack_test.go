@@ -2,6 +2,7 @@ package stack
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,6 +30,364 @@ func TestNewStack(t *testing.T) {
 	})
 }
 
+func TestNewDynamicStack(t *testing.T) {
+	s := NewDynamicStack[int]()
+	require.NotNil(t, s)
+	assert.True(t, s.IsEmpty())
+	assert.False(t, s.IsFull())
+	assert.Equal(t, 0, s.Count())
+	assert.Equal(t, minDynamicCapacity, s.Size())
+
+	_, err := s.Pop()
+	assert.Equal(t, ErrorStackUnderflow, err)
+	_, err = s.Peek()
+	assert.Equal(t, ErrorStackUnderflow, err)
+}
+
+func TestDynamicStack_GrowAndShrink(t *testing.T) {
+	s := NewDynamicStack[int]()
+
+	const n = 1000
+	for i := 0; i < n; i++ {
+		require.NoError(t, s.Push(i))
+		assert.False(t, s.IsFull())
+	}
+	assert.Equal(t, n, s.Count())
+	assert.GreaterOrEqual(t, s.Size(), n)
+	grown := s.Size()
+
+	peeked, err := s.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, n-1, peeked)
+
+	for i := n - 1; i >= 0; i-- {
+		popped, err := s.Pop()
+		require.NoError(t, err)
+		assert.Equal(t, i, popped)
+		assert.LessOrEqual(t, s.Count(), s.Size())
+	}
+	assert.True(t, s.IsEmpty())
+	assert.Less(t, s.Size(), grown)
+	assert.Equal(t, minDynamicCapacity, s.Size())
+
+	// The stack stays usable after shrinking back down
+	require.NoError(t, s.Push(7))
+	popped, err := s.Pop()
+	require.NoError(t, err)
+	assert.Equal(t, 7, popped)
+}
+
+func TestStack_PushAll(t *testing.T) {
+	t.Run("all items fit", func(t *testing.T) {
+		s := NewStack[int](4)
+		n, err := s.PushAll([]int{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []int{1, 2, 3}, s.ToSlice())
+		top, err := s.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, 3, top)
+	})
+
+	t.Run("partial fit returns overflow", func(t *testing.T) {
+		s := NewStack[int](3)
+		require.NoError(t, s.Push(0))
+		n, err := s.PushAll([]int{1, 2, 3, 4})
+		assert.ErrorIs(t, err, ErrorStackOverflow)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []int{0, 1, 2}, s.ToSlice())
+		assert.True(t, s.IsFull())
+	})
+
+	t.Run("dynamic stack grows to fit", func(t *testing.T) {
+		s := NewDynamicStack[int]()
+		n, err := s.PushAll([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+		require.NoError(t, err)
+		assert.Equal(t, 9, n)
+		assert.Equal(t, 16, s.Size())
+		assert.Equal(t, 9, s.Count())
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		s := NewStack[int](1)
+		n, err := s.PushAll(nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.True(t, s.IsEmpty())
+	})
+}
+
+func TestStack_PopN(t *testing.T) {
+	t.Run("fixed stack", func(t *testing.T) {
+		s := NewStack[int](5)
+		_, err := s.PopN(1)
+		assert.ErrorIs(t, err, ErrorStackUnderflow)
+
+		_, err = s.PushAll([]int{1, 2, 3, 4, 5})
+		require.NoError(t, err)
+
+		items, err := s.PopN(0)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+		assert.Equal(t, 5, s.Count())
+
+		items, err = s.PopN(2)
+		require.NoError(t, err)
+		assert.Equal(t, []int{5, 4}, items)
+
+		items, err = s.PopN(10)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 2, 1}, items, "fewer than n items returns what is left")
+		assert.True(t, s.IsEmpty())
+		assert.Equal(t, 5, s.Size())
+	})
+
+	t.Run("dynamic stack shrinks", func(t *testing.T) {
+		s := NewDynamicStack[int]()
+		items := make([]int, 64)
+		for i := range items {
+			items[i] = i
+		}
+		_, err := s.PushAll(items)
+		require.NoError(t, err)
+		assert.Equal(t, 64, s.Size())
+
+		popped, err := s.PopN(62)
+		require.NoError(t, err)
+		assert.Len(t, popped, 62)
+		assert.Equal(t, 63, popped[0])
+		assert.Equal(t, []int{0, 1}, s.ToSlice())
+		assert.Equal(t, minDynamicCapacity, s.Size())
+	})
+}
+
+func TestStack_ShrinkToFit(t *testing.T) {
+	t.Run("dynamic stack", func(t *testing.T) {
+		s := NewDynamicStack[int]()
+		for i := 0; i < 100; i++ {
+			require.NoError(t, s.Push(i))
+		}
+		// Pop down to just above the automatic shrink threshold
+		for i := 0; i < 60; i++ {
+			_, err := s.Pop()
+			require.NoError(t, err)
+		}
+		require.Greater(t, s.Size(), 40)
+
+		s.ShrinkToFit()
+		assert.Equal(t, 40, s.Size())
+		assert.Equal(t, 40, s.Count())
+		assert.False(t, s.IsEmpty())
+		top, err := s.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, 39, top)
+		items := s.ToSlice()
+		for i, v := range items {
+			require.Equal(t, i, v)
+		}
+
+		// Growing still works after shrinking
+		require.NoError(t, s.Push(40))
+		assert.Equal(t, 41, s.Count())
+	})
+
+	t.Run("never below the minimum capacity", func(t *testing.T) {
+		s := NewDynamicStack[int]()
+		for i := 0; i < 9; i++ {
+			require.NoError(t, s.Push(i))
+		}
+		for i := 0; i < 8; i++ {
+			_, err := s.Pop()
+			require.NoError(t, err)
+		}
+		s.ShrinkToFit()
+		assert.Equal(t, minDynamicCapacity, s.Size())
+		assert.Equal(t, []int{0}, s.ToSlice())
+	})
+
+	t.Run("fixed stack is unchanged", func(t *testing.T) {
+		s := NewStack[int](10)
+		require.NoError(t, s.Push(1))
+		s.ShrinkToFit()
+		assert.Equal(t, 10, s.Size())
+		assert.Equal(t, []int{1}, s.ToSlice())
+	})
+}
+
+func TestStack_ToSlice(t *testing.T) {
+	s := NewStack[int](5)
+	assert.Equal(t, []int{}, s.ToSlice())
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, s.Push(i))
+	}
+	items := s.ToSlice()
+	assert.Equal(t, []int{1, 2, 3}, items)
+
+	// Modifying the returned slice must not affect the stack
+	items[2] = 99
+	top, err := s.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, 3, top)
+}
+
+func TestStack_All(t *testing.T) {
+	s := NewStack[int](4)
+	assert.Empty(t, slices.Collect(s.All()))
+
+	_, err := s.PushAll([]int{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(s.All()), "bottom to top")
+
+	var seen []int
+	for item := range s.All() {
+		seen = append(seen, item)
+		_, err := s.Pop() // modifying the stack inside the loop is allowed
+		require.NoError(t, err)
+		if len(seen) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, seen)
+	assert.Equal(t, 1, s.Count())
+}
+
+func TestStack_Clear(t *testing.T) {
+	s := NewStack[int](3)
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, s.Push(i))
+	}
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 0, s.Count())
+	assert.Equal(t, 3, s.Size())
+	assert.Equal(t, []int{0, 0, 0}, s.items)
+
+	_, err := s.Pop()
+	assert.Equal(t, ErrorStackUnderflow, err)
+	for i := 4; i <= 6; i++ {
+		require.NoError(t, s.Push(i))
+	}
+	assert.Equal(t, []int{4, 5, 6}, s.ToSlice())
+}
+
+func TestStack_Clone(t *testing.T) {
+	t.Run("fixed stack", func(t *testing.T) {
+		s := NewStack[int](4)
+		require.NoError(t, s.Push(1))
+		require.NoError(t, s.Push(2))
+
+		clone := s.Clone()
+		assert.Equal(t, s.ToSlice(), clone.ToSlice())
+		assert.Equal(t, s.Size(), clone.Size())
+
+		require.NoError(t, clone.Push(3))
+		_, err := s.Pop()
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{1}, s.ToSlice())
+		assert.Equal(t, []int{1, 2, 3}, clone.ToSlice())
+	})
+
+	t.Run("dynamic stack", func(t *testing.T) {
+		s := NewDynamicStack[int]()
+		for i := 0; i < 10; i++ {
+			require.NoError(t, s.Push(i))
+		}
+
+		clone := s.Clone()
+		for i := 10; i < 20; i++ {
+			require.NoError(t, clone.Push(i))
+		}
+		assert.Equal(t, 10, s.Count())
+		assert.Equal(t, 20, clone.Count())
+		assert.False(t, clone.IsFull())
+	})
+}
+
+func TestSearch(t *testing.T) {
+	s := NewStack[string](5)
+	assert.Equal(t, -1, Search(s, "a"))
+
+	for _, v := range []string{"a", "b", "c", "b"} {
+		require.NoError(t, s.Push(v))
+	}
+
+	testCases := []struct {
+		value    string
+		expected int
+	}{
+		{"b", 1}, // nearest occurrence from the top wins
+		{"c", 2},
+		{"a", 4},
+		{"z", -1},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Search(s, tc.value), "search %q", tc.value)
+	}
+
+	// Search does not modify the stack
+	assert.Equal(t, []string{"a", "b", "c", "b"}, s.ToSlice())
+
+	_, err := s.Pop()
+	require.NoError(t, err)
+	assert.Equal(t, 2, Search(s, "b"))
+}
+
+func TestEqual(t *testing.T) {
+	a := NewStack[string](3)
+	b := NewDynamicStack[string]()
+	assert.True(t, Equal(a, b), "empty stacks are equal")
+	assert.True(t, Equal(a, a))
+
+	_, err := a.PushAll([]string{"x", "y"})
+	require.NoError(t, err)
+	_, err = b.PushAll([]string{"x", "y"})
+	require.NoError(t, err)
+	assert.True(t, Equal(a, b), "different capacities and modes")
+	assert.True(t, Equal(b, a))
+	assert.Equal(t, 2, a.Count(), "Equal does not pop items")
+	assert.Equal(t, 2, b.Count())
+
+	require.NoError(t, b.Push("z"))
+	assert.False(t, Equal(a, b), "different lengths")
+
+	c := NewStack[string](3)
+	_, err = c.PushAll([]string{"y", "x"})
+	require.NoError(t, err)
+	assert.False(t, Equal(a, c), "same items in a different order")
+
+	assert.True(t, Equal(a, a.Clone()))
+}
+
+func TestStack_CapacityLenUtilization(t *testing.T) {
+	x := NewStack[int](4)
+	assert.Equal(t, 4, x.Capacity())
+	assert.Equal(t, 0, x.Len())
+	assert.Equal(t, 0.0, x.Utilization())
+
+	require.NoError(t, x.Push(1))
+	assert.Equal(t, x.Size(), x.Capacity())
+	assert.Equal(t, x.Count(), x.Len())
+	assert.Equal(t, 1, x.Len())
+	assert.Equal(t, 0.25, x.Utilization())
+
+	for i := 2; i <= 4; i++ {
+		require.NoError(t, x.Push(i))
+	}
+	assert.Equal(t, 1.0, x.Utilization())
+
+	d := NewDynamicStack[int]()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, d.Push(i))
+	}
+	assert.Equal(t, d.Size(), d.Capacity())
+	assert.Equal(t, 100, d.Len())
+	assert.InDelta(t, float64(d.Len())/float64(d.Capacity()), d.Utilization(), 1e-9)
+	assert.LessOrEqual(t, d.Utilization(), 1.0)
+}
+
 func TestIsEmpty(t *testing.T) {
 	s := NewStack[int](5)
 	assert.True(t, s.IsEmpty())
@@ -171,6 +530,55 @@ func TestPeek(t *testing.T) {
 	})
 }
 
+func TestStack_PeekBottom(t *testing.T) {
+	s := NewStack[int](3)
+	_, err := s.PeekBottom()
+	assert.ErrorIs(t, err, ErrorStackUnderflow)
+
+	for _, v := range []int{1, 2, 3} {
+		require.NoError(t, s.Push(v))
+	}
+	bottom, err := s.PeekBottom()
+	require.NoError(t, err)
+	assert.Equal(t, 1, bottom)
+	assert.Equal(t, 3, s.Count(), "PeekBottom must not remove items")
+
+	_, _ = s.Pop()
+	_, _ = s.Pop()
+	bottom, err = s.PeekBottom()
+	require.NoError(t, err)
+	assert.Equal(t, 1, bottom)
+}
+
+func TestStack_ForEachTopToBottom(t *testing.T) {
+	s := NewDynamicStack[string]()
+
+	var visited []string
+	s.ForEachTopToBottom(func(item string) bool {
+		visited = append(visited, item)
+		return true
+	})
+	assert.Empty(t, visited)
+
+	for _, v := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, s.Push(v))
+	}
+
+	s.ForEachTopToBottom(func(item string) bool {
+		visited = append(visited, item)
+		return true
+	})
+	assert.Equal(t, []string{"d", "c", "b", "a"}, visited)
+
+	visited = nil
+	s.ForEachTopToBottom(func(item string) bool {
+		visited = append(visited, item)
+		return item != "c"
+	})
+	assert.Equal(t, []string{"d", "c"}, visited)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, s.ToSlice(), "iteration must not modify the stack")
+}
+
 func TestLIFOBehavior(t *testing.T) {
 	s := NewStack[string](5)
 
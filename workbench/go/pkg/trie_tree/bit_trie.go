@@ -0,0 +1,46 @@
+package trietree
+
+import "fmt"
+
+// BitTrie is a binary trie keyed by bit strings, for CIDR-style longest-prefix routing:
+// each stored prefix maps to a value, and Lookup returns the value of the most specific
+// prefix that covers an address. It is a thin wrapper around TrieTree[bool, V] and is
+// safe for concurrent use.
+type BitTrie[V any] struct {
+	trie *TrieTree[bool, V]
+}
+
+// NewBitTrie creates and returns an empty BitTrie.
+func NewBitTrie[V any]() *BitTrie[V] {
+	return &BitTrie[V]{trie: NewTrieTree[bool, V]()}
+}
+
+// AddPrefix stores value for the prefix made of the first length bits of bits, like the
+// network part of a CIDR block such as 10.0.0.0/8. A length of 0 adds a default route
+// that matches every address. Adding the same prefix again replaces its value.
+// It panics if length is negative or greater than len(bits).
+func (b *BitTrie[V]) AddPrefix(bits []bool, length int, value V) {
+	if length < 0 || length > len(bits) {
+		panic(fmt.Sprintf("trietree: prefix length %d out of range [0, %d]", length, len(bits)))
+	}
+	b.trie.Insert(bits[:length], value)
+}
+
+// Lookup returns the value of the longest stored prefix of bits, i.e. the most specific
+// route for the address. The boolean reports whether any prefix matched.
+func (b *BitTrie[V]) Lookup(bits []bool) (V, bool) {
+	value, _, found := b.trie.LongestPrefixMatch(bits)
+	return value, found
+}
+
+// BytesToBits decomposes bytes into their bits, most significant bit first, so that
+// addresses such as the 4 bytes of an IPv4 address can be used as BitTrie keys.
+func BytesToBits(data []byte) []bool {
+	bits := make([]bool, 0, len(data)*8)
+	for _, b := range data {
+		for i := 7; i >= 0; i-- {
+			bits = append(bits, b&(1<<i) != 0)
+		}
+	}
+	return bits
+}
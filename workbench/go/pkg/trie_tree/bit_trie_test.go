@@ -0,0 +1,81 @@
+package trietree
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ipv4Bits returns the 32 bits of an IPv4 address.
+func ipv4Bits(t *testing.T, addr string) []bool {
+	t.Helper()
+	ip, err := netip.ParseAddr(addr)
+	require.NoError(t, err)
+	b := ip.As4()
+	return BytesToBits(b[:])
+}
+
+// addRoute adds a CIDR block such as "10.0.0.0/8" to the trie.
+func addRoute(t *testing.T, trie *BitTrie[string], cidr, hop string) {
+	t.Helper()
+	prefix, err := netip.ParsePrefix(cidr)
+	require.NoError(t, err)
+	b := prefix.Addr().As4()
+	trie.AddPrefix(BytesToBits(b[:]), prefix.Bits(), hop)
+}
+
+func TestBitTrie_LongestPrefixRouting(t *testing.T) {
+	routes := NewBitTrie[string]()
+	_, found := routes.Lookup(ipv4Bits(t, "10.0.0.1"))
+	assert.False(t, found, "an empty table has no route")
+
+	addRoute(t, routes, "10.0.0.0/8", "core")
+	addRoute(t, routes, "10.1.0.0/16", "branch")
+	addRoute(t, routes, "10.1.2.0/24", "lab")
+	addRoute(t, routes, "192.168.1.1/32", "gateway")
+
+	tests := []struct {
+		addr  string
+		want  string
+		found bool
+	}{
+		{"10.200.3.4", "core", true},
+		{"10.1.9.9", "branch", true},
+		{"10.1.2.200", "lab", true},
+		{"10.1.3.1", "branch", true},
+		{"192.168.1.1", "gateway", true},
+		{"192.168.1.2", "", false},
+		{"11.0.0.1", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			hop, found := routes.Lookup(ipv4Bits(t, tt.addr))
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, hop)
+		})
+	}
+
+	addRoute(t, routes, "0.0.0.0/0", "default")
+	hop, found := routes.Lookup(ipv4Bits(t, "11.0.0.1"))
+	assert.True(t, found)
+	assert.Equal(t, "default", hop)
+
+	addRoute(t, routes, "10.0.0.0/8", "core-2")
+	hop, _ = routes.Lookup(ipv4Bits(t, "10.200.3.4"))
+	assert.Equal(t, "core-2", hop, "adding a prefix again replaces its value")
+}
+
+func TestBitTrie_AddPrefixInvalidLength(t *testing.T) {
+	routes := NewBitTrie[int]()
+	bits := BytesToBits([]byte{10})
+	assert.Panics(t, func() { routes.AddPrefix(bits, 9, 1) })
+	assert.Panics(t, func() { routes.AddPrefix(bits, -1, 1) })
+}
+
+func TestBytesToBits(t *testing.T) {
+	assert.Equal(t, []bool{true, false, false, false, false, false, false, true}, BytesToBits([]byte{0x81}))
+	assert.Len(t, BytesToBits([]byte{1, 2, 3, 4}), 32)
+	assert.Empty(t, BytesToBits(nil))
+}
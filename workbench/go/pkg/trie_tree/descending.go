@@ -0,0 +1,56 @@
+package trietree
+
+import (
+	"cmp"
+	"slices"
+)
+
+// KeysDescending returns every key in the trie in descending lexicographic order,
+// comparing key elements with cmp.Compare. A key sorts after each of its prefixes, so
+// "ab" is returned before "a". It is the reverse of the sorted output of Keys.
+//
+// It is a function rather than a method because it needs ordered key elements, while
+// TrieTree only requires K to be comparable.
+func KeysDescending[K cmp.Ordered, V any](t *TrieTree[K, V]) [][]K {
+	var results [][]K
+	WalkDescending(t, func(key []K, _ V) bool {
+		results = append(results, key)
+		return true
+	})
+	return results
+}
+
+// WalkDescending calls fn for every key and its value in descending lexicographic order,
+// visiting the children of each node in reverse cmp.Compare order and a node's own key
+// after all keys that extend it. Walking stops early if fn returns false.
+// Each key passed to fn is a fresh copy that fn may keep.
+// The trie is read-locked during the walk, so fn must not modify it.
+func WalkDescending[K cmp.Ordered, V any](t *TrieTree[K, V], fn func([]K, V) bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	walkDescending(t.root, nil, fn)
+}
+
+// walkDescending visits current's subtree in descending order and reports whether
+// the walk should continue.
+func walkDescending[K cmp.Ordered, V any](current *node[K, V], currentKey []K, fn func([]K, V) bool) bool {
+	children := make([]K, 0, len(current.children))
+	for k := range current.children {
+		children = append(children, k)
+	}
+	slices.SortFunc(children, func(a, b K) int { return cmp.Compare(b, a) })
+
+	for _, k := range children {
+		nextKey := make([]K, len(currentKey)+1)
+		copy(nextKey, currentKey)
+		nextKey[len(currentKey)] = k
+		if !walkDescending(current.children[k], nextKey, fn) {
+			return false
+		}
+	}
+	if current.isEnd {
+		return fn(slices.Clone(currentKey), current.value)
+	}
+	return true
+}
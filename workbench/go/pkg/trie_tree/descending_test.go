@@ -0,0 +1,50 @@
+package trietree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeysDescending(t *testing.T) {
+	trie := NewTrieTree[byte, int]()
+	assert.Empty(t, KeysDescending(trie))
+
+	for i, word := range []string{"a", "ab", "abc", "b", "ba", "apple", ""} {
+		trie.Insert([]byte(word), i)
+	}
+
+	var got []string
+	for _, key := range KeysDescending(trie) {
+		got = append(got, string(key))
+	}
+	assert.Equal(t, []string{"ba", "b", "apple", "abc", "ab", "a", ""}, got)
+}
+
+func TestWalkDescending(t *testing.T) {
+	trie := NewTrieTree[int, string]()
+	trie.Insert([]int{1, 2}, "one-two")
+	trie.Insert([]int{1, 10}, "one-ten")
+	trie.Insert([]int{3}, "three")
+
+	t.Run("visits keys with values in descending order", func(t *testing.T) {
+		var keys [][]int
+		var values []string
+		WalkDescending(trie, func(key []int, value string) bool {
+			keys = append(keys, key)
+			values = append(values, value)
+			return true
+		})
+		assert.Equal(t, [][]int{{3}, {1, 10}, {1, 2}}, keys)
+		assert.Equal(t, []string{"three", "one-ten", "one-two"}, values)
+	})
+
+	t.Run("stops when fn returns false", func(t *testing.T) {
+		var keys [][]int
+		WalkDescending(trie, func(key []int, _ string) bool {
+			keys = append(keys, key)
+			return len(keys) < 2
+		})
+		assert.Equal(t, [][]int{{3}, {1, 10}}, keys)
+	})
+}
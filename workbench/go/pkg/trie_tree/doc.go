@@ -26,12 +26,59 @@
 //
 // Time Complexities:
 //   - Insert: O(m) where m is the length of the key
+//   - InsertMany: O(k*m) for k keys of average length m, under a single lock acquisition
 //   - Search: O(m) where m is the length of the key
 //   - Delete: O(m) where m is the length of the key
 //   - StartsWith: O(m) where m is the length of the prefix
 //   - Size: O(n) where n is the total number of nodes in the trie
 //   - Keys: O(n*m) where n is the number of keys and m is the average key length
+//   - All: O(n*m) to take the snapshot when iteration starts
 //   - KeysWithPrefix: O(k*m) where k is the number of matching keys and m is the average key length
+//   - Equals: O(n) where n is the total number of nodes in the trie
+//   - KeysWithValue: O(n) where n is the total number of nodes in the trie
+//   - LongestCommonPrefix: O(p) where p is the length of the returned prefix
+//   - LongestPrefixMatch: O(m) where m is the length of the key
+//   - KeysDescending, WalkDescending: O(n log a) where a is the largest number of children of a node
+//   - ToDOT: O(n log n) where n is the total number of nodes in the trie
+//   - PrefixNode: O(m) where m is the length of the prefix; PrefixCursor.Extend: O(1)
+//
+// For incremental lookups such as per-keystroke autocomplete, PrefixNode returns a
+// PrefixCursor that can be extended one element at a time instead of re-walking from the root:
+//
+//	cursor, ok := trie.PrefixNode([]byte("he"))
+//	cursor, ok = cursor.Extend('l')
+//	keys := cursor.Keys() // every key starting with "hel"
+//
+// All returns an iter.Seq2 over a snapshot of every key and value, so the trie works with
+// range-over-func loops and the maps and slices helpers:
+//
+//	for key, value := range trie.All() {
+//		fmt.Println(string(key), value)
+//	}
+//
+// Keys returns keys in no particular order. For ordered key elements, KeysDescending and
+// WalkDescending visit keys in descending lexicographic order ("latest first"), with each key
+// after the keys that extend it; WalkDescending stops as soon as its callback returns false:
+//
+//	trietree.WalkDescending(trie, func(key []byte, value string) bool {
+//		fmt.Println(string(key), value)
+//		return true
+//	})
+//
+// ToDOT writes the trie as a Graphviz digraph with edges labeled by key element and
+// terminal nodes drawn as double circles, which makes shared prefixes easy to see:
+//
+//	var sb strings.Builder
+//	_ = trie.ToDOT(&sb) // render with: dot -Tpng trie.dot -o trie.png
+//
+// BitTrie specializes the trie to bit keys for CIDR-style routing. AddPrefix stores a
+// value for the first length bits of an address and Lookup returns the most specific match,
+// using LongestPrefixMatch; BytesToBits turns address bytes into bits:
+//
+//	routes := trietree.NewBitTrie[string]()
+//	routes.AddPrefix(trietree.BytesToBits([]byte{10, 0, 0, 0}), 8, "core")
+//	routes.AddPrefix(trietree.BytesToBits([]byte{10, 1, 0, 0}), 16, "branch")
+//	hop, ok := routes.Lookup(trietree.BytesToBits([]byte{10, 1, 2, 3})) // "branch", true
 //
 // Space Complexity: O(ALPHABET_SIZE * N * M) where ALPHABET_SIZE is the number of possible
 // key elements, N is the number of keys, and M is the average length of the keys.
@@ -0,0 +1,81 @@
+package trietree
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// ToDOT writes the trie to w as a Graphviz digraph. Each edge is labeled with the key
+// element it consumes and nodes that end a key are drawn as double circles.
+// Printable byte and rune keys are rendered as characters; other bytes are shown in hex.
+// Children are emitted in order of their labels, so the output is deterministic.
+// It returns the first error encountered while writing to w.
+func (t *TrieTree[K, V]) ToDOT(w io.Writer) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph Trie {")
+	fmt.Fprintln(bw, "\tnode [shape=circle, label=\"\"];")
+
+	nextID := 0
+	var visit func(n *node[K, V]) int
+	visit = func(n *node[K, V]) int {
+		id := nextID
+		nextID++
+		if n.isEnd {
+			fmt.Fprintf(bw, "\tn%d [shape=doublecircle];\n", id)
+		} else {
+			fmt.Fprintf(bw, "\tn%d;\n", id)
+		}
+
+		type edge struct {
+			label string
+			child *node[K, V]
+		}
+		edges := make([]edge, 0, len(n.children))
+		for k, child := range n.children {
+			edges = append(edges, edge{dotLabel(k), child})
+		}
+		slices.SortFunc(edges, func(a, b edge) int {
+			if a.label < b.label {
+				return -1
+			} else if a.label > b.label {
+				return 1
+			}
+			return 0
+		})
+
+		for _, e := range edges {
+			childID := visit(e.child)
+			fmt.Fprintf(bw, "\tn%d -> n%d [label=%q];\n", id, childID, e.label)
+		}
+		return id
+	}
+	visit(t.root)
+
+	fmt.Fprintln(bw, "}")
+	// bufio.Writer keeps the first write error, so checking Flush covers every write above.
+	return bw.Flush()
+}
+
+// dotLabel renders a key element as an edge label.
+func dotLabel[K comparable](k K) string {
+	switch v := any(k).(type) {
+	case byte:
+		if v >= 0x20 && v < 0x7f {
+			return string(rune(v))
+		}
+		return fmt.Sprintf("0x%02x", v)
+	case rune:
+		if strconv.IsPrint(v) {
+			return string(v)
+		}
+		return fmt.Sprintf("%U", v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
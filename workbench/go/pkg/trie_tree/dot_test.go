@@ -0,0 +1,53 @@
+package trietree
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrieTree_ToDOT(t *testing.T) {
+	t.Run("empty trie", func(t *testing.T) {
+		trie := NewTrieTree[byte, int]()
+
+		var sb strings.Builder
+		require.NoError(t, trie.ToDOT(&sb))
+		assert.Equal(t, "digraph Trie {\n\tnode [shape=circle, label=\"\"];\n\tn0;\n}\n", sb.String())
+	})
+
+	t.Run("shared prefix", func(t *testing.T) {
+		trie := NewTrieTree[byte, int]()
+		trie.Insert([]byte("to"), 1)
+		trie.Insert([]byte("tea"), 2)
+		trie.Insert([]byte("t"), 3)
+
+		var sb strings.Builder
+		require.NoError(t, trie.ToDOT(&sb))
+		want := `digraph Trie {
+	node [shape=circle, label=""];
+	n0;
+	n1 [shape=doublecircle];
+	n2;
+	n3 [shape=doublecircle];
+	n2 -> n3 [label="a"];
+	n1 -> n2 [label="e"];
+	n4 [shape=doublecircle];
+	n1 -> n4 [label="o"];
+	n0 -> n1 [label="t"];
+}
+`
+		assert.Equal(t, want, sb.String())
+	})
+}
+
+func TestDotLabel(t *testing.T) {
+	assert.Equal(t, "a", dotLabel[byte]('a'))
+	assert.Equal(t, `"`, dotLabel[byte]('"'))
+	assert.Equal(t, "0x0a", dotLabel[byte]('\n'))
+	assert.Equal(t, "0xff", dotLabel[byte](0xff))
+	assert.Equal(t, "é", dotLabel[rune]('é'))
+	assert.Equal(t, "U+0007", dotLabel[rune]('\a'))
+	assert.Equal(t, "42", dotLabel[int](42))
+}
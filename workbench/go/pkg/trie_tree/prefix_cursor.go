@@ -0,0 +1,82 @@
+package trietree
+
+// PrefixCursor is a handle to the subtree of a trie that holds every key starting with a prefix.
+// It lets callers narrow a prefix one element at a time, as in per-keystroke autocomplete,
+// without walking down from the root on every query.
+//
+// A cursor reads the trie under its read lock, so it is safe alongside concurrent readers.
+// Deleting keys can detach the subtree a cursor points to; obtain a new cursor from the
+// trie after modifications.
+type PrefixCursor[K comparable, V any] struct {
+	trie   *TrieTree[K, V]
+	node   *node[K, V]
+	prefix []K
+}
+
+// PrefixNode returns a cursor positioned at the subtree for prefix, and false if
+// no key in the trie starts with prefix. An empty prefix returns a cursor at the root.
+func (t *TrieTree[K, V]) PrefixNode(prefix []K) (*PrefixCursor[K, V], bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	current := t.root
+	for _, k := range prefix {
+		child, exists := current.children[k]
+		if !exists {
+			return nil, false
+		}
+		current = child
+	}
+
+	prefixCopy := make([]K, len(prefix))
+	copy(prefixCopy, prefix)
+	return &PrefixCursor[K, V]{trie: t, node: current, prefix: prefixCopy}, true
+}
+
+// Prefix returns a copy of the prefix the cursor is positioned at.
+func (c *PrefixCursor[K, V]) Prefix() []K {
+	prefix := make([]K, len(c.prefix))
+	copy(prefix, c.prefix)
+	return prefix
+}
+
+// Extend returns a new cursor for the prefix extended by k, and false if no key
+// continues with k. It costs O(1) regardless of the prefix length; c is left unchanged.
+func (c *PrefixCursor[K, V]) Extend(k K) (*PrefixCursor[K, V], bool) {
+	c.trie.mu.RLock()
+	defer c.trie.mu.RUnlock()
+
+	child, exists := c.node.children[k]
+	if !exists {
+		return nil, false
+	}
+
+	prefix := make([]K, len(c.prefix)+1)
+	copy(prefix, c.prefix)
+	prefix[len(c.prefix)] = k
+	return &PrefixCursor[K, V]{trie: c.trie, node: child, prefix: prefix}, true
+}
+
+// Value returns the value stored for the cursor's prefix itself, and false
+// if the prefix is not a complete key.
+func (c *PrefixCursor[K, V]) Value() (V, bool) {
+	c.trie.mu.RLock()
+	defer c.trie.mu.RUnlock()
+
+	if !c.node.isEnd {
+		var zero V
+		return zero, false
+	}
+	return c.node.value, true
+}
+
+// Keys returns every key in the cursor's subtree, each including the prefix.
+// Like TrieTree.Keys, the order of the keys is unspecified.
+func (c *PrefixCursor[K, V]) Keys() [][]K {
+	c.trie.mu.RLock()
+	defer c.trie.mu.RUnlock()
+
+	var results [][]K
+	c.trie.collectKeys(c.node, c.Prefix(), &results)
+	return results
+}
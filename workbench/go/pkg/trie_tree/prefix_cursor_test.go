@@ -0,0 +1,77 @@
+package trietree
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sortedStrings converts byte keys to strings and sorts them for stable comparisons.
+func sortedStrings(keys [][]byte) []string {
+	result := make([]string, len(keys))
+	for i, key := range keys {
+		result[i] = string(key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestTrieTree_PrefixNode(t *testing.T) {
+	trie := NewTrieTree[byte, int]()
+	for i, word := range []string{"car", "card", "care", "cat", "dog"} {
+		trie.Insert([]byte(word), i)
+	}
+
+	t.Run("missing prefix", func(t *testing.T) {
+		cursor, ok := trie.PrefixNode([]byte("cow"))
+		assert.False(t, ok)
+		assert.Nil(t, cursor)
+	})
+
+	t.Run("empty prefix is the root", func(t *testing.T) {
+		cursor, ok := trie.PrefixNode(nil)
+		require.True(t, ok)
+		assert.Empty(t, cursor.Prefix())
+		assert.Equal(t, []string{"car", "card", "care", "cat", "dog"}, sortedStrings(cursor.Keys()))
+	})
+
+	t.Run("incremental autocomplete", func(t *testing.T) {
+		cursor, ok := trie.PrefixNode([]byte("c"))
+		require.True(t, ok)
+		assert.Equal(t, []string{"car", "card", "care", "cat"}, sortedStrings(cursor.Keys()))
+
+		cursor, ok = cursor.Extend('a')
+		require.True(t, ok)
+		cursor, ok = cursor.Extend('r')
+		require.True(t, ok)
+		assert.Equal(t, []byte("car"), cursor.Prefix())
+		assert.Equal(t, []string{"car", "card", "care"}, sortedStrings(cursor.Keys()))
+
+		value, isKey := cursor.Value()
+		assert.True(t, isKey)
+		assert.Equal(t, 0, value)
+
+		next, ok := cursor.Extend('t')
+		assert.False(t, ok)
+		assert.Nil(t, next)
+		assert.Equal(t, []byte("car"), cursor.Prefix(), "failed Extend must not change the cursor")
+	})
+
+	t.Run("prefix that is not a key", func(t *testing.T) {
+		cursor, ok := trie.PrefixNode([]byte("ca"))
+		require.True(t, ok)
+		_, isKey := cursor.Value()
+		assert.False(t, isKey)
+	})
+
+	t.Run("cursor does not alias caller prefix", func(t *testing.T) {
+		prefix := []byte("do")
+		cursor, ok := trie.PrefixNode(prefix)
+		require.True(t, ok)
+		prefix[0] = 'x'
+		assert.Equal(t, []byte("do"), cursor.Prefix())
+		assert.Equal(t, []string{"dog"}, sortedStrings(cursor.Keys()))
+	})
+}
@@ -2,11 +2,14 @@ package trietree
 
 import (
 	"errors"
+	"iter"
 	"sync"
 )
 
 var (
 	ErrKeyNotFound = errors.New("key not found in trie tree")
+	// ErrLengthMismatch is returned by InsertMany when keys and values have different lengths.
+	ErrLengthMismatch = errors.New("keys and values have different lengths")
 )
 
 type TrieTree[K comparable, V any] struct {
@@ -33,6 +36,31 @@ func (t *TrieTree[K, V]) Insert(key []K, value V) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	t.insert(key, value)
+}
+
+// InsertMany inserts every keys[i] with values[i], e.g. to build a trie from a word list.
+// The write lock is acquired once for the whole batch instead of once per key, and other
+// goroutines never observe a partially inserted batch. As with Insert, a repeated key keeps
+// the last value. If the slices have different lengths, it returns ErrLengthMismatch and
+// inserts nothing.
+func (t *TrieTree[K, V]) InsertMany(keys [][]K, values []V) error {
+	if len(keys) != len(values) {
+		return ErrLengthMismatch
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, key := range keys {
+		t.insert(key, values[i])
+	}
+	return nil
+}
+
+// insert adds key with value, creating missing nodes along the path.
+// This method assumes the caller already holds the write lock.
+func (t *TrieTree[K, V]) insert(key []K, value V) {
 	current := t.root
 	for _, k := range key {
 		if _, exists := current.children[k]; !exists {
@@ -174,6 +202,36 @@ func (t *TrieTree[K, V]) Keys() [][]K {
 	return results
 }
 
+// All returns an iterator over every key and its value, for use with range-over-func:
+//
+//	for key, value := range trie.All() {
+//		fmt.Println(string(key), value)
+//	}
+//
+// The entries are copied under the read lock when iteration starts, so the loop body may
+// modify the trie and sees the trie as it was at that moment. Each key is a fresh slice.
+// Like Keys, the order is unspecified; use WalkDescending for an ordered walk.
+func (t *TrieTree[K, V]) All() iter.Seq2[[]K, V] {
+	return func(yield func([]K, V) bool) {
+		t.mu.RLock()
+		var keys [][]K
+		var values []V
+		walk(t.root, nil, func(key []K, n *node[K, V]) {
+			if n.isEnd {
+				keys = append(keys, key)
+				values = append(values, n.value)
+			}
+		})
+		t.mu.RUnlock()
+
+		for i, key := range keys {
+			if !yield(key, values[i]) {
+				return
+			}
+		}
+	}
+}
+
 func (t *TrieTree[K, V]) KeysWithPrefix(prefix []K) ([][]K, error) {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -191,18 +249,166 @@ func (t *TrieTree[K, V]) KeysWithPrefix(prefix []K) ([][]K, error) {
 	return results, nil
 }
 
+// collectKeys appends to results every key under current, each prefixed by currentKey.
+// This method assumes the caller already holds the read lock.
 func (t *TrieTree[K, V]) collectKeys(current *node[K, V], currentKey []K, results *[][]K) {
-	if current.isEnd {
-		// Make a copy of currentKey to avoid mutation issues
-		keyCopy := make([]K, len(currentKey))
-		copy(keyCopy, currentKey)
-		*results = append(*results, keyCopy)
-	}
-	for k, child := range current.children {
-		// append function can return a reference to the same underlying array, so we need to be careful
-		nextKey := make([]K, len(currentKey)+1)
-		copy(nextKey, currentKey)
-		nextKey[len(currentKey)] = k
-		t.collectKeys(child, nextKey, results)
+	walk(current, currentKey, func(key []K, n *node[K, V]) {
+		if n.isEnd {
+			*results = append(*results, key)
+		}
+	})
+}
+
+// walk visits current and every node below it depth-first, calling fn with each node and
+// its key, where current's key is currentKey. Each key is a freshly allocated slice that is
+// never modified afterwards, so fn may keep it. Children are visited in map order.
+// The caller must hold the read lock.
+func walk[K comparable, V any](current *node[K, V], currentKey []K, fn func(key []K, n *node[K, V])) {
+	var visit func(n *node[K, V], key []K)
+	visit = func(n *node[K, V], key []K) {
+		fn(key, n)
+		for k, child := range n.children {
+			// Give each child its own backing array so siblings never share one
+			nextKey := make([]K, len(key)+1)
+			copy(nextKey, key)
+			nextKey[len(key)] = k
+			visit(child, nextKey)
+		}
+	}
+	key := make([]K, len(currentKey))
+	copy(key, currentKey)
+	visit(current, key)
+}
+
+// LongestPrefixMatch finds the longest stored key that is a prefix of key (including key
+// itself) and returns its value and length, as used for longest-prefix routing.
+// The boolean reports whether any stored key matched; a stored empty key matches everything.
+func (t *TrieTree[K, V]) LongestPrefixMatch(key []K) (V, int, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var value V
+	length, found := 0, false
+	current := t.root
+	for i := 0; ; i++ {
+		if current.isEnd {
+			value, length, found = current.value, i, true
+		}
+		if i == len(key) {
+			break
+		}
+		next, exists := current.children[key[i]]
+		if !exists {
+			break
+		}
+		current = next
+	}
+	return value, length, found
+}
+
+// LongestCommonPrefix returns the longest prefix shared by every key in the trie.
+// It walks down from the root while the current node has exactly one child and does not
+// end a key. An empty trie, a root with several children, or a stored empty key all yield
+// an empty, non-nil slice.
+func (t *TrieTree[K, V]) LongestCommonPrefix() []K {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	prefix := []K{}
+	current := t.root
+	for !current.isEnd && len(current.children) == 1 {
+		for k, child := range current.children {
+			prefix = append(prefix, k)
+			current = child
+		}
+	}
+	return prefix
+}
+
+// KeysWithValue returns every key whose stored value matches value according to eq,
+// e.g. to find which keys map to a given tag. It visits every node, so it runs in O(n)
+// for n nodes. The keys are returned in no particular order; if none match, it returns nil.
+//
+// If eq is nil, values are compared with ==. As with Equals, this panics for values whose
+// dynamic type is not comparable; pass an explicit eq for those types.
+func (t *TrieTree[K, V]) KeysWithValue(value V, eq func(a, b V) bool) [][]K {
+	if eq == nil {
+		eq = func(a, b V) bool { return any(a) == any(b) }
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var results [][]K
+	walk(t.root, nil, func(key []K, n *node[K, V]) {
+		if n.isEnd && eq(n.value, value) {
+			results = append(results, key)
+		}
+	})
+	return results
+}
+
+// Equals reports whether t and other contain exactly the same keys, with the values
+// for each key considered equal by valueEq.
+//
+// If valueEq is nil, values are compared with ==. V is not constrained to be comparable,
+// so in that case Equals panics if it compares two values whose dynamic type is not
+// comparable (such as slices or maps); pass an explicit valueEq for those types.
+//
+// other is copied under its own read lock before t is read-locked for the comparison,
+// so the two tries are never locked together. This costs O(n) extra memory for the copy.
+func (t *TrieTree[K, V]) Equals(other *TrieTree[K, V], valueEq func(a, b V) bool) bool {
+	if t == other {
+		return true
+	}
+	if other == nil {
+		return false
+	}
+	if valueEq == nil {
+		valueEq = func(a, b V) bool { return any(a) == any(b) }
 	}
+
+	// The tries are never locked at the same time: other is copied under its read lock
+	// and the copy is compared under t's, so concurrent a.Equals(b) and b.Equals(a)
+	// cannot deadlock while writers wait on both.
+	other.mu.RLock()
+	otherRoot := cloneNode(other.root)
+	other.mu.RUnlock()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return equalNodes(t.root, otherRoot, valueEq)
+}
+
+// cloneNode returns a copy of the subtree rooted at n. Values are copied shallowly.
+// The caller must hold the read lock of the trie that owns n.
+func cloneNode[K comparable, V any](n *node[K, V]) *node[K, V] {
+	clone := &node[K, V]{
+		children: make(map[K]*node[K, V], len(n.children)),
+		value:    n.value,
+		isEnd:    n.isEnd,
+	}
+	for k, child := range n.children {
+		clone.children[k] = cloneNode(child)
+	}
+	return clone
+}
+
+// equalNodes reports whether the subtrees rooted at a and b hold the same keys and values.
+// Delete prunes branches that no longer lead to a key, so equal key sets imply equal shapes.
+func equalNodes[K comparable, V any](a, b *node[K, V], valueEq func(a, b V) bool) bool {
+	if a.isEnd != b.isEnd || len(a.children) != len(b.children) {
+		return false
+	}
+	if a.isEnd && !valueEq(a.value, b.value) {
+		return false
+	}
+	for k, childA := range a.children {
+		childB, exists := b.children[k]
+		if !exists || !equalNodes(childA, childB, valueEq) {
+			return false
+		}
+	}
+	return true
 }
@@ -640,3 +640,253 @@ func TestTrieTree_ConcurrentPrefixes(t *testing.T) {
 
 	assert.Equal(t, 4, trie.Size(), "Should have 4 keys after deletion")
 }
+
+func TestTrieTree_Equals(t *testing.T) {
+	build := func(entries map[string]int) *TrieTree[byte, int] {
+		trie := NewTrieTree[byte, int]()
+		for key, value := range entries {
+			trie.Insert([]byte(key), value)
+		}
+		return trie
+	}
+
+	a := build(map[string]int{"car": 1, "cart": 2, "dog": 3})
+	b := build(map[string]int{"dog": 3, "cart": 2, "car": 1})
+
+	assert.True(t, a.Equals(a, nil))
+	assert.True(t, a.Equals(b, nil))
+	assert.True(t, b.Equals(a, nil))
+	assert.False(t, a.Equals(nil, nil))
+	assert.True(t, NewTrieTree[byte, int]().Equals(NewTrieTree[byte, int](), nil))
+
+	t.Run("different value", func(t *testing.T) {
+		c := build(map[string]int{"car": 1, "cart": 20, "dog": 3})
+		assert.False(t, a.Equals(c, nil))
+
+		sameParity := func(x, y int) bool { return x%2 == y%2 }
+		assert.True(t, a.Equals(c, sameParity))
+	})
+
+	t.Run("prefix is not a key", func(t *testing.T) {
+		c := build(map[string]int{"cart": 2, "dog": 3})
+		assert.False(t, a.Equals(c, nil))
+	})
+
+	t.Run("equal after delete", func(t *testing.T) {
+		c := build(map[string]int{"car": 1, "cart": 2, "dog": 3, "doghouse": 4})
+		assert.False(t, a.Equals(c, nil))
+		require.NoError(t, c.Delete([]byte("doghouse")))
+		assert.True(t, a.Equals(c, nil))
+	})
+
+	t.Run("non-comparable values", func(t *testing.T) {
+		x := NewTrieTree[byte, []int]()
+		y := NewTrieTree[byte, []int]()
+		x.Insert([]byte("k"), []int{1, 2})
+		y.Insert([]byte("k"), []int{1, 2})
+
+		sliceEq := func(p, q []int) bool { return fmt.Sprint(p) == fmt.Sprint(q) }
+		assert.True(t, x.Equals(y, sliceEq))
+		assert.Panics(t, func() { x.Equals(y, nil) })
+	})
+}
+
+func TestTrieTree_EqualsConcurrentOpposite(t *testing.T) {
+	a := NewTrieTree[int, int]()
+	b := NewTrieTree[int, int]()
+
+	// Opposite-order Equals calls racing with writers on both tries must not deadlock.
+	var g errgroup.Group
+	for i := 0; i < 4; i++ {
+		g.Go(func() error {
+			for j := 0; j < 200; j++ {
+				a.Equals(b, nil)
+				b.Equals(a, nil)
+			}
+			return nil
+		})
+		g.Go(func() error {
+			for j := 0; j < 200; j++ {
+				a.Insert([]int{i, j}, j)
+				b.Insert([]int{i, j}, j)
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait())
+	assert.True(t, a.Equals(b, nil))
+}
+
+func TestTrieTree_KeysWithValue(t *testing.T) {
+	trie := NewTrieTree[rune, string]()
+	trie.Insert([]rune("apple"), "fruit")
+	trie.Insert([]rune("app"), "software")
+	trie.Insert([]rune("banana"), "fruit")
+	trie.Insert([]rune("carrot"), "vegetable")
+	trie.Insert([]rune(""), "fruit")
+
+	toStrings := func(keys [][]rune) []string {
+		out := make([]string, len(keys))
+		for i, k := range keys {
+			out[i] = string(k)
+		}
+		return out
+	}
+
+	assert.ElementsMatch(t, []string{"apple", "banana", ""}, toStrings(trie.KeysWithValue("fruit", nil)))
+	assert.ElementsMatch(t, []string{"app"}, toStrings(trie.KeysWithValue("software", nil)))
+	assert.Nil(t, trie.KeysWithValue("mineral", nil))
+
+	prefixEq := func(a, b string) bool { return len(a) > 0 && len(b) > 0 && a[0] == b[0] }
+	assert.ElementsMatch(t, []string{"apple", "banana", ""}, toStrings(trie.KeysWithValue("f", prefixEq)))
+
+	t.Run("non-comparable values need eq", func(t *testing.T) {
+		tags := NewTrieTree[byte, []string]()
+		tags.Insert([]byte("go"), []string{"lang", "google"})
+		tags.Insert([]byte("rust"), []string{"lang"})
+		hasTag := func(stored, want []string) bool {
+			for _, s := range stored {
+				if s == want[0] {
+					return true
+				}
+			}
+			return false
+		}
+		keys := tags.KeysWithValue([]string{"google"}, hasTag)
+		require.Len(t, keys, 1)
+		assert.Equal(t, []byte("go"), keys[0])
+		assert.Panics(t, func() { tags.KeysWithValue([]string{"lang"}, nil) })
+	})
+}
+
+func TestTrieTree_InsertMany(t *testing.T) {
+	trie := NewTrieTree[rune, int]()
+	words := []string{"car", "cart", "care", "dog", "car"}
+	keys := make([][]rune, len(words))
+	values := make([]int, len(words))
+	for i, w := range words {
+		keys[i] = []rune(w)
+		values[i] = i
+	}
+
+	require.NoError(t, trie.InsertMany(keys, values))
+	assert.Equal(t, 4, trie.Size())
+	v, ok := trie.Search([]rune("car"))
+	assert.True(t, ok)
+	assert.Equal(t, 4, v, "a repeated key keeps the last value")
+	v, ok = trie.Search([]rune("dog"))
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	assert.ErrorIs(t, trie.InsertMany([][]rune{[]rune("cat")}, nil), ErrLengthMismatch)
+	_, ok = trie.Search([]rune("cat"))
+	assert.False(t, ok, "nothing is inserted on a length mismatch")
+
+	require.NoError(t, trie.InsertMany(nil, nil))
+	assert.Equal(t, 4, trie.Size())
+}
+
+func TestTrieTree_LongestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		want string
+	}{
+		{"empty trie", nil, ""},
+		{"single key", []string{"flower"}, "flower"},
+		{"shared prefix", []string{"flower", "flow", "flight"}, "fl"},
+		{"key is the prefix", []string{"interview", "internet", "inter"}, "inter"},
+		{"no shared prefix", []string{"dog", "cat"}, ""},
+		{"terminal empty key", []string{"", "abc"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trie := NewTrieTree[rune, struct{}]()
+			for _, k := range tt.keys {
+				trie.Insert([]rune(k), struct{}{})
+			}
+			got := trie.LongestCommonPrefix()
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+
+	t.Run("after delete", func(t *testing.T) {
+		trie := NewTrieTree[rune, int]()
+		trie.Insert([]rune("team"), 1)
+		trie.Insert([]rune("tea"), 2)
+		trie.Insert([]rune("ten"), 3)
+		assert.Equal(t, "te", string(trie.LongestCommonPrefix()))
+		require.NoError(t, trie.Delete([]rune("ten")))
+		assert.Equal(t, "tea", string(trie.LongestCommonPrefix()))
+	})
+}
+
+func TestTrieTree_LongestPrefixMatch(t *testing.T) {
+	trie := NewTrieTree[rune, int]()
+	_, _, found := trie.LongestPrefixMatch([]rune("anything"))
+	assert.False(t, found)
+
+	trie.Insert([]rune("a"), 1)
+	trie.Insert([]rune("abc"), 3)
+	trie.Insert([]rune("abcde"), 5)
+
+	tests := []struct {
+		key       string
+		wantValue int
+		wantLen   int
+		wantFound bool
+	}{
+		{"abcd", 3, 3, true},
+		{"abcdef", 5, 5, true},
+		{"abc", 3, 3, true},
+		{"ab", 1, 1, true},
+		{"b", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		value, length, found := trie.LongestPrefixMatch([]rune(tt.key))
+		assert.Equal(t, tt.wantFound, found, tt.key)
+		assert.Equal(t, tt.wantValue, value, tt.key)
+		assert.Equal(t, tt.wantLen, length, tt.key)
+	}
+
+	trie.Insert([]rune(""), 0)
+	value, length, found := trie.LongestPrefixMatch([]rune("b"))
+	assert.True(t, found, "the empty key matches everything")
+	assert.Equal(t, 0, value)
+	assert.Equal(t, 0, length)
+}
+
+func TestTrieTree_All(t *testing.T) {
+	trie := NewTrieTree[byte, int]()
+	for range trie.All() {
+		t.Fatal("an empty trie yields nothing")
+	}
+
+	want := map[string]int{"a": 1, "ab": 2, "abc": 3, "b": 4}
+	for key, value := range want {
+		trie.Insert([]byte(key), value)
+	}
+
+	got := make(map[string]int)
+	for key, value := range trie.All() {
+		got[string(key)] = value
+		// The loop body may modify the trie; iteration sees the entries at the start.
+		require.NoError(t, trie.Delete(key))
+	}
+	assert.Equal(t, want, got)
+	assert.True(t, trie.IsEmpty())
+
+	trie.Insert([]byte("x"), 1)
+	trie.Insert([]byte("y"), 2)
+	count := 0
+	for key := range trie.All() {
+		key[0] = 'z' // keys are copies
+		count++
+		break
+	}
+	assert.Equal(t, 1, count, "stops when the consumer stops")
+	assert.ElementsMatch(t, [][]byte{[]byte("x"), []byte("y")}, trie.Keys())
+}
@@ -2,9 +2,18 @@
 // repository.
 //
 // The package currently exposes ScanStdin, a convenience function for reading
-// all lines from standard input until EOF. It supports a functional option,
-// WithMaxCapacity, to configure the maximum token size used by the underlying
-// bufio.Scanner.
+// all lines from standard input until EOF, and ScanReader, which does the same
+// for any io.Reader. They support a functional option, WithMaxCapacity, to
+// configure the maximum token size used by the underlying bufio.Scanner, and
+// WithSplit, to tokenize input by something other than newlines.
+// ReadInts and ReadFields parse whitespace-separated input, the usual shape of
+// competitive programming data:
+//
+//	nums, err := utils.ReadInts(os.Stdin)     // every integer in the input
+//	fields, err := utils.ReadFields(os.Stdin) // strings.Fields of each line
+//
+// WriteLines is the output counterpart, writing newline-terminated lines
+// through a buffered writer that is flushed before it returns.
 //
 // Example:
 //
@@ -15,5 +24,14 @@
 //	lines := utils.ScanStdin(utils.WithMaxCapacity(1 << 20))
 //
 // Be mindful that ScanStdin accumulates all lines in memory before returning,
-// so callers should consider input size when using it.
+// so callers should consider input size when using it. ScanStdinChan streams
+// lines over a channel instead, which keeps memory use independent of input size:
+//
+//	lines, errc := utils.ScanStdinChan()
+//	for line := range lines {
+//		fmt.Println(line)
+//	}
+//	if err := <-errc; err != nil {
+//		log.Fatal(err)
+//	}
 package utils
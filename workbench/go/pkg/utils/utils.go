@@ -2,11 +2,16 @@ package utils
 
 import (
 	"bufio"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 )
 
 type options struct {
 	maxCapacity int
+	split       bufio.SplitFunc
 }
 
 type Option func(*options)
@@ -26,6 +31,20 @@ func WithMaxCapacity(maxCapacity int) Option {
 	}
 }
 
+// WithSplit returns an Option that sets the split function used by the
+// underlying bufio.Scanner within ScanStdin, ScanReader and ScanStdinChan, so
+// input can be tokenized by something other than newlines. Each token produced
+// by fn becomes one element of the result. The default is bufio.ScanLines.
+//
+// Pass bufio.ScanWords to read whitespace-separated words, or a custom
+// bufio.SplitFunc for comma- or NUL-delimited input.
+// ReadInts always splits on whitespace and ignores this option.
+func WithSplit(fn bufio.SplitFunc) Option {
+	return func(o *options) {
+		o.split = fn
+	}
+}
+
 // ScanStdin reads all lines from standard input until EOF and returns them as a
 // slice of strings. It is a convenience wrapper around bufio.Scanner.
 //
@@ -33,6 +52,8 @@ func WithMaxCapacity(maxCapacity int) Option {
 //   - By default, the maximum token size (i.e., maximum line length) is
 //     bufio.MaxScanTokenSize. You can override this using the WithMaxCapacity
 //     option to support longer lines.
+//   - Lines are split with bufio.ScanLines unless another split function is
+//     configured using the WithSplit option.
 //   - This function accumulates all lines in memory before returning; consider
 //     input size when using it.
 //   - Errors from bufio.Scanner (including bufio.ErrTooLong when a line exceeds
@@ -48,16 +69,23 @@ func WithMaxCapacity(maxCapacity int) Option {
 //	// Read allowing lines up to 1 MiB
 //	lines := utils.ScanStdin(utils.WithMaxCapacity(1 << 20))
 func ScanStdin(opts ...Option) ([]string, error) {
-	options := &options{
-		maxCapacity: bufio.MaxScanTokenSize,
-	}
-	for _, opt := range opts {
-		opt(options)
-	}
+	return ScanReader(os.Stdin, opts...)
+}
 
-	scanner := bufio.NewScanner(os.Stdin)
-	buf := make([]byte, 0, options.maxCapacity)
-	scanner.Buffer(buf, options.maxCapacity)
+// ScanReader reads all lines from r until EOF and returns them as a slice of
+// strings. It behaves exactly like ScanStdin but accepts any io.Reader, which
+// makes it usable with files, network connections or a strings.Reader in tests.
+//
+// Errors from bufio.Scanner (including bufio.ErrTooLong when a line exceeds the
+// configured capacity) are returned together with the lines collected before
+// scanning stopped.
+//
+// Example:
+//
+//	lines, err := utils.ScanReader(strings.NewReader("a\nb\n"))
+//	// lines: ["a", "b"], err: nil
+func ScanReader(r io.Reader, opts ...Option) ([]string, error) {
+	scanner := newScanner(r, opts)
 
 	var lines []string
 	for scanner.Scan() {
@@ -69,3 +97,136 @@ func ScanStdin(opts ...Option) ([]string, error) {
 	}
 	return lines, nil
 }
+
+// ReadInts reads whitespace-separated integers from r until EOF and returns them
+// in input order. Tokens may be separated by any mix of spaces, tabs and newlines.
+// Each token is parsed with strconv.Atoi; on the first token that is not a valid
+// integer, ReadInts returns the integers parsed so far and an error that names
+// the offending token and wraps the strconv error.
+// WithMaxCapacity bounds the length of a single token.
+//
+// Example:
+//
+//	nums, err := utils.ReadInts(strings.NewReader("3\n1 4 1\n5"))
+//	// nums: [3, 1, 4, 1, 5], err: nil
+func ReadInts(r io.Reader, opts ...Option) ([]int, error) {
+	scanner := newScanner(r, opts)
+	scanner.Split(bufio.ScanWords)
+
+	var nums []int
+	for scanner.Scan() {
+		token := scanner.Text()
+		n, err := strconv.Atoi(token)
+		if err != nil {
+			return nums, fmt.Errorf("parse token %q: %w", token, err)
+		}
+		nums = append(nums, n)
+	}
+	return nums, scanner.Err()
+}
+
+// ReadFields reads lines from r until EOF and splits each one into its
+// whitespace-separated fields with strings.Fields. The result has one entry per
+// line; blank lines produce an empty slice.
+// WithMaxCapacity bounds the length of a single line.
+//
+// Example:
+//
+//	fields, err := utils.ReadFields(strings.NewReader("alice 30\nbob 25\n"))
+//	// fields: [["alice", "30"], ["bob", "25"]], err: nil
+func ReadFields(r io.Reader, opts ...Option) ([][]string, error) {
+	scanner := newScanner(r, opts)
+
+	var fields [][]string
+	for scanner.Scan() {
+		fields = append(fields, strings.Fields(scanner.Text()))
+	}
+	return fields, scanner.Err()
+}
+
+// WriteLines writes each line to w followed by a newline. Output goes through a
+// bufio.Writer that is flushed before WriteLines returns, so many short lines
+// cost few writes to w. The first write or flush error is returned.
+//
+// WriteLines is the counterpart of ScanReader: lines written by it read back
+// unchanged as long as they contain no newlines themselves.
+//
+// Example:
+//
+//	if err := utils.WriteLines(os.Stdout, []string{"a", "b"}); err != nil {
+//	    log.Fatal(err)
+//	}
+func WriteLines(w io.Writer, lines []string) error {
+	bw := bufio.NewWriter(w)
+	for _, line := range lines {
+		if _, err := bw.WriteString(line); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ScanStdinChan reads lines from standard input and streams them over the returned
+// line channel as they are scanned, so arbitrarily large input can be processed without
+// holding it all in memory. The line channel is closed once scanning stops.
+//
+// The error channel delivers at most one value: the bufio.Scanner error (for example
+// bufio.ErrTooLong when a line exceeds the configured capacity) if scanning failed.
+// It is closed after the line channel, so receiving from it once the lines are drained
+// yields either the error or nil. The same options as ScanStdin are supported.
+//
+// Callers must drain the line channel; the scanning goroutine blocks until each line is received.
+//
+// Example:
+//
+//	lines, errc := utils.ScanStdinChan(utils.WithMaxCapacity(1 << 20))
+//	for line := range lines {
+//	    process(line)
+//	}
+//	if err := <-errc; err != nil {
+//	    log.Fatal(err)
+//	}
+func ScanStdinChan(opts ...Option) (<-chan string, <-chan error) {
+	return scanChan(os.Stdin, opts)
+}
+
+// scanChan streams the lines read from r over a channel, reporting any scanner
+// error on a second, buffered channel once the line channel is closed.
+func scanChan(r io.Reader, opts []Option) (<-chan string, <-chan error) {
+	lines := make(chan string)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(errc)
+
+		scanner := newScanner(r, opts)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+		if err := scanner.Err(); err != nil {
+			errc <- err
+		}
+	}()
+	return lines, errc
+}
+
+// newScanner creates a bufio.Scanner over r configured by opts.
+func newScanner(r io.Reader, opts []Option) *bufio.Scanner {
+	options := &options{
+		maxCapacity: bufio.MaxScanTokenSize,
+		split:       bufio.ScanLines,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	scanner := bufio.NewScanner(r)
+	buf := make([]byte, 0, options.maxCapacity)
+	scanner.Buffer(buf, options.maxCapacity)
+	scanner.Split(options.split)
+	return scanner
+}
@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanReader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"empty input", "", nil},
+		{"single line without newline", "hello", []string{"hello"}},
+		{"multiple lines", "a\nb\nc\n", []string{"a", "b", "c"}},
+		{"blank lines are kept", "a\n\nb\n", []string{"a", "", "b"}},
+		{"windows line endings", "a\r\nb\r\n", []string{"a", "b"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lines, err := ScanReader(strings.NewReader(tc.input))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, lines)
+		})
+	}
+}
+
+func TestScanReader_MaxCapacity(t *testing.T) {
+	input := "short\n" + strings.Repeat("x", 100) + "\nafter\n"
+
+	lines, err := ScanReader(strings.NewReader(input), WithMaxCapacity(16))
+	assert.ErrorIs(t, err, bufio.ErrTooLong)
+	assert.Equal(t, []string{"short"}, lines, "lines before the long one should be returned")
+
+	lines, err = ScanReader(strings.NewReader(input), WithMaxCapacity(1<<10))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"short", strings.Repeat("x", 100), "after"}, lines)
+}
+
+func TestScanReader_WithSplit(t *testing.T) {
+	t.Run("scan words", func(t *testing.T) {
+		tokens, err := ScanReader(strings.NewReader("a b\n  c\td\n"), WithSplit(bufio.ScanWords))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d"}, tokens)
+	})
+
+	t.Run("comma separated", func(t *testing.T) {
+		scanCommas := func(data []byte, atEOF bool) (int, []byte, error) {
+			if i := strings.IndexByte(string(data), ','); i >= 0 {
+				return i + 1, data[:i], nil
+			}
+			if atEOF && len(data) > 0 {
+				return len(data), data, nil
+			}
+			return 0, nil, nil
+		}
+
+		tokens, err := ScanReader(strings.NewReader("apple,banana,,cherry"), WithSplit(scanCommas))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"apple", "banana", "", "cherry"}, tokens)
+	})
+}
+
+func TestScanChan(t *testing.T) {
+	t.Run("streams all lines", func(t *testing.T) {
+		lines, errc := scanChan(strings.NewReader("a\nb\nc\n"), nil)
+
+		var got []string
+		for line := range lines {
+			got = append(got, line)
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, got)
+		assert.NoError(t, <-errc)
+	})
+
+	t.Run("reports scanner errors", func(t *testing.T) {
+		input := "ok\n" + strings.Repeat("x", 100) + "\n"
+		lines, errc := scanChan(strings.NewReader(input), []Option{WithMaxCapacity(16)})
+
+		var got []string
+		for line := range lines {
+			got = append(got, line)
+		}
+		assert.Equal(t, []string{"ok"}, got)
+		assert.ErrorIs(t, <-errc, bufio.ErrTooLong)
+	})
+}
+
+func TestReadInts(t *testing.T) {
+	t.Run("mixed whitespace", func(t *testing.T) {
+		nums, err := ReadInts(strings.NewReader("3\n1 4\t1\n\n  -5 9\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 1, 4, 1, -5, 9}, nums)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		nums, err := ReadInts(strings.NewReader(""))
+		require.NoError(t, err)
+		assert.Empty(t, nums)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		nums, err := ReadInts(strings.NewReader("1 2 x3 4"))
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+		assert.Contains(t, err.Error(), `"x3"`)
+		assert.Equal(t, []int{1, 2}, nums)
+	})
+
+	t.Run("token longer than capacity", func(t *testing.T) {
+		_, err := ReadInts(strings.NewReader("1 "+strings.Repeat("9", 32)), WithMaxCapacity(8))
+		assert.ErrorIs(t, err, bufio.ErrTooLong)
+	})
+}
+
+func TestReadFields(t *testing.T) {
+	fields, err := ReadFields(strings.NewReader("alice 30\n\n  bob\t25  extra\n"))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"alice", "30"}, {}, {"bob", "25", "extra"}}, fields)
+
+	_, err = ReadFields(strings.NewReader(strings.Repeat("a ", 32)), WithMaxCapacity(8))
+	assert.ErrorIs(t, err, bufio.ErrTooLong)
+}
+
+// failingWriter accepts limit bytes and then fails every write.
+type failingWriter struct {
+	limit int
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, errWriteFailed
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func TestWriteLines(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		lines := []string{"first", "", "third line"}
+		var sb strings.Builder
+		require.NoError(t, WriteLines(&sb, lines))
+		assert.Equal(t, "first\n\nthird line\n", sb.String())
+
+		got, err := ScanReader(strings.NewReader(sb.String()))
+		require.NoError(t, err)
+		assert.Equal(t, lines, got)
+	})
+
+	t.Run("no lines", func(t *testing.T) {
+		var sb strings.Builder
+		require.NoError(t, WriteLines(&sb, nil))
+		assert.Empty(t, sb.String())
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		lines := []string{strings.Repeat("x", 8192), "y"}
+		assert.ErrorIs(t, WriteLines(&failingWriter{limit: 10}, lines), errWriteFailed)
+		assert.ErrorIs(t, WriteLines(&failingWriter{limit: 0}, []string{"short"}), errWriteFailed)
+	})
+}